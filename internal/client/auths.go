@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// SessionUser describes the identity associated with the current credentials.
+type SessionUser struct {
+	ID              string         `json:"id"`
+	Email           string         `json:"email"`
+	Name            string         `json:"name"`
+	Role            string         `json:"role"`
+	ProfileImageURL string         `json:"profile_image_url"`
+	Token           string         `json:"token"`
+	TokenType       string         `json:"token_type"`
+	ExpiresAt       *int64         `json:"expires_at,omitempty"`
+	Permissions     map[string]any `json:"permissions,omitempty"`
+}
+
+// APIKey holds the API key of the authenticated user.
+type APIKey struct {
+	APIKey *string `json:"api_key"`
+}
+
+// GetSessionUser returns the identity of the authenticated caller.
+func (c *Client) GetSessionUser(ctx context.Context) (*SessionUser, error) {
+	var resp SessionUser
+	if err := c.do(ctx, http.MethodGet, "auths/", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAPIKey retrieves the API key of the authenticated user.
+func (c *Client) GetAPIKey(ctx context.Context) (*APIKey, error) {
+	var resp APIKey
+	if err := c.do(ctx, http.MethodGet, "auths/api_key", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GenerateAPIKey creates a new API key for the authenticated user, replacing any existing key.
+func (c *Client) GenerateAPIKey(ctx context.Context) (*APIKey, error) {
+	var resp APIKey
+	if err := c.do(ctx, http.MethodPost, "auths/api_key", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteAPIKey revokes the API key of the authenticated user.
+func (c *Client) DeleteAPIKey(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, "auths/api_key", nil, nil, nil)
+}
+
+// SigninForm carries email and password credentials.
+type SigninForm struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// Signin authenticates with email and password and returns the new session.
+func (c *Client) Signin(ctx context.Context, form SigninForm) (*SessionUser, error) {
+	var resp SessionUser
+	if err := c.do(ctx, http.MethodPost, "auths/signin", nil, form, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Signout ends the session associated with the client's token.
+func (c *Client) Signout(ctx context.Context) error {
+	return c.do(ctx, http.MethodGet, "auths/signout", nil, nil, nil)
+}
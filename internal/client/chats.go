@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ChatImportForm represents the payload for importing a chat.
+type ChatImportForm struct {
+	Chat     map[string]any `json:"chat"`
+	Meta     map[string]any `json:"meta,omitempty"`
+	Pinned   bool           `json:"pinned"`
+	FolderID *string        `json:"folder_id"`
+}
+
+// ChatResponse is returned by the chat endpoints.
+type ChatResponse struct {
+	ID        string         `json:"id"`
+	UserID    string         `json:"user_id"`
+	Title     string         `json:"title"`
+	Chat      map[string]any `json:"chat"`
+	CreatedAt int64          `json:"created_at"`
+	UpdatedAt int64          `json:"updated_at"`
+	ShareID   *string        `json:"share_id,omitempty"`
+	Archived  bool           `json:"archived"`
+	Pinned    *bool          `json:"pinned,omitempty"`
+	Meta      map[string]any `json:"meta,omitempty"`
+	FolderID  *string        `json:"folder_id,omitempty"`
+}
+
+// ImportChat stores a complete chat, including its message history.
+func (c *Client) ImportChat(ctx context.Context, form ChatImportForm) (*ChatResponse, error) {
+	var resp ChatResponse
+	if err := c.do(ctx, http.MethodPost, "chats/import", nil, form, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetChat fetches a chat by identifier.
+func (c *Client) GetChat(ctx context.Context, id string) (*ChatResponse, error) {
+	var resp ChatResponse
+	path := fmt.Sprintf("chats/%s", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.ID == "" {
+		return nil, ErrNotFound
+	}
+
+	return &resp, nil
+}
+
+// UpdateChat merges chat into the stored chat document.
+func (c *Client) UpdateChat(ctx context.Context, id string, chat map[string]any) (*ChatResponse, error) {
+	body := map[string]any{
+		"chat": chat,
+	}
+
+	var resp ChatResponse
+	path := fmt.Sprintf("chats/%s", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodPost, path, nil, body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteChat removes a chat by identifier.
+func (c *Client) DeleteChat(ctx context.Context, id string) error {
+	path := fmt.Sprintf("chats/%s", url.PathEscape(id))
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// ToggleChatPin flips the pinned flag of a chat.
+func (c *Client) ToggleChatPin(ctx context.Context, id string) error {
+	path := fmt.Sprintf("chats/%s/pin", url.PathEscape(id))
+	return c.do(ctx, http.MethodPost, path, nil, nil, nil)
+}
+
+// ToggleChatArchive flips the archived flag of a chat.
+func (c *Client) ToggleChatArchive(ctx context.Context, id string) error {
+	path := fmt.Sprintf("chats/%s/archive", url.PathEscape(id))
+	return c.do(ctx, http.MethodPost, path, nil, nil, nil)
+}
+
+// ShareChat publishes a snapshot of a chat, or refreshes the existing snapshot.
+func (c *Client) ShareChat(ctx context.Context, id string) (*ChatResponse, error) {
+	var resp ChatResponse
+	path := fmt.Sprintf("chats/%s/share", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UnshareChat removes the shared snapshot of a chat.
+func (c *Client) UnshareChat(ctx context.Context, id string) error {
+	path := fmt.Sprintf("chats/%s/share", url.PathEscape(id))
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// UpdateChatFolder moves a chat into folderID, or out of any folder when folderID is nil.
+func (c *Client) UpdateChatFolder(ctx context.Context, id string, folderID *string) error {
+	body := map[string]any{
+		"folder_id": folderID,
+	}
+
+	path := fmt.Sprintf("chats/%s/folder", url.PathEscape(id))
+	return c.do(ctx, http.MethodPost, path, nil, body, nil)
+}
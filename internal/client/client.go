@@ -62,20 +62,16 @@ func NewClient(endpoint, token string) (*Client, error) {
 	}, nil
 }
 
-// do performs an HTTP request against the API.
-func (c *Client) do(ctx context.Context, method, path string, query url.Values, payload any, out any) error {
-	var body io.Reader
-
-	if payload != nil {
-		buf := &bytes.Buffer{}
-		enc := json.NewEncoder(buf)
-		enc.SetEscapeHTML(false)
-		if err := enc.Encode(payload); err != nil {
-			return fmt.Errorf("encode request body: %w", err)
-		}
-		body = buf
-	}
+// WithToken returns a copy of the client that authenticates with the supplied token.
+// An empty token produces an unauthenticated client.
+func (c *Client) WithToken(token string) *Client {
+	clone := *c
+	clone.token = token
+	return &clone
+}
 
+// resolveURL joins an API path and optional query parameters onto the base URL.
+func (c *Client) resolveURL(path string, query url.Values) string {
 	fullURL := c.baseURL
 	trimmedPath := strings.TrimLeft(path, "/")
 	if trimmedPath != "" {
@@ -89,7 +85,24 @@ func (c *Client) do(ctx context.Context, method, path string, query url.Values,
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+	return fullURL
+}
+
+// do performs an HTTP request against the API.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, payload any, out any) error {
+	var body io.Reader
+
+	if payload != nil {
+		buf := &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(payload); err != nil {
+			return fmt.Errorf("encode request body: %w", err)
+		}
+		body = buf
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.resolveURL(path, query), body)
 	if err != nil {
 		return fmt.Errorf("create request: %w", err)
 	}
@@ -99,6 +112,11 @@ func (c *Client) do(ctx context.Context, method, path string, query url.Values,
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	return c.send(req, out)
+}
+
+// send authenticates and executes a prepared request, decoding the JSON response into out.
+func (c *Client) send(req *http.Request, out any) error {
 	if c.token != "" {
 		req.Header.Set("Authorization", "Bearer "+c.token)
 	}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ExportConfig returns the full Open WebUI configuration document.
+func (c *Client) ExportConfig(ctx context.Context) (map[string]any, error) {
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodGet, "configs/export", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ImportConfig replaces the Open WebUI configuration with the supplied document.
+func (c *Client) ImportConfig(ctx context.Context, config map[string]any) (map[string]any, error) {
+	body := map[string]any{
+		"config": config,
+	}
+
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodPost, "configs/import", nil, body, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
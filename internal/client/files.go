@@ -0,0 +1,168 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// FileResponse captures file details returned by the upload endpoint.
+type FileResponse struct {
+	ID        string         `json:"id"`
+	UserID    string         `json:"user_id"`
+	Hash      *string        `json:"hash,omitempty"`
+	Filename  string         `json:"filename"`
+	Data      map[string]any `json:"data,omitempty"`
+	Meta      map[string]any `json:"meta,omitempty"`
+	CreatedAt int64          `json:"created_at"`
+	UpdatedAt int64          `json:"updated_at"`
+}
+
+// UploadFile uploads raw file content using a multipart form request.
+func (c *Client) UploadFile(ctx context.Context, filename string, content []byte) (*FileResponse, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	contentType := mime.TypeByExtension(filepath.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filename)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("create multipart part: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("write multipart content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("finalize multipart body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.resolveURL("files/", nil), buf)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var resp FileResponse
+	if err := c.send(req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetFile retrieves file metadata by identifier.
+func (c *Client) GetFile(ctx context.Context, id string) (*FileModel, error) {
+	var resp FileModel
+	path := fmt.Sprintf("files/%s", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteFile removes a file by identifier.
+func (c *Client) DeleteFile(ctx context.Context, id string) error {
+	path := fmt.Sprintf("files/%s", url.PathEscape(id))
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// File processing states reported in the data.status field.
+const (
+	FileStatusPending   = "pending"
+	FileStatusCompleted = "completed"
+	FileStatusFailed    = "failed"
+)
+
+// FileProcessingError reports that Open WebUI failed to extract or embed a file.
+type FileProcessingError struct {
+	FileID  string
+	Message string
+}
+
+func (e *FileProcessingError) Error() string {
+	if strings.TrimSpace(e.Message) == "" {
+		return fmt.Sprintf("openwebui: processing of file %s failed", e.FileID)
+	}
+
+	return fmt.Sprintf("openwebui: processing of file %s failed: %s", e.FileID, e.Message)
+}
+
+// WaitForFileProcessing polls a file until Open WebUI reports that processing completed,
+// returning a FileProcessingError when it failed or an error once timeout elapses.
+func (c *Client) WaitForFileProcessing(ctx context.Context, id string, timeout, interval time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		done, err := c.fileProcessed(waitCtx, id)
+		if err != nil {
+			if waitCtx.Err() != nil && ctx.Err() == nil {
+				return fmt.Errorf("openwebui: timed out after %s waiting for file %s to be processed", timeout, id)
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("openwebui: timed out after %s waiting for file %s to be processed", timeout, id)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// fileProcessed inspects data.status and falls back to the content endpoint for servers
+// that do not report a processing status.
+func (c *Client) fileProcessed(ctx context.Context, id string) (bool, error) {
+	file, err := c.GetFile(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	status, _ := file.Data["status"].(string)
+	switch status {
+	case FileStatusCompleted:
+		return true, nil
+	case FileStatusFailed:
+		message, _ := file.Data["error"].(string)
+		return false, &FileProcessingError{FileID: id, Message: message}
+	case "":
+		path := fmt.Sprintf("files/%s/data/content", url.PathEscape(id))
+		if err := c.do(ctx, http.MethodGet, path, nil, nil, nil); err != nil {
+			var apiErr *APIError
+			if errors.Is(err, ErrNotFound) || (errors.As(err, &apiErr) && apiErr.Status == http.StatusBadRequest) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
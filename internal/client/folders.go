@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// FolderForm represents the payload for creating or renaming a folder.
+type FolderForm struct {
+	Name string `json:"name"`
+}
+
+// FolderModel is returned by the folder endpoints.
+type FolderModel struct {
+	ID         string         `json:"id"`
+	ParentID   *string        `json:"parent_id,omitempty"`
+	UserID     string         `json:"user_id"`
+	Name       string         `json:"name"`
+	Items      map[string]any `json:"items,omitempty"`
+	Meta       map[string]any `json:"meta,omitempty"`
+	IsExpanded bool           `json:"is_expanded"`
+	CreatedAt  int64          `json:"created_at"`
+	UpdatedAt  int64          `json:"updated_at"`
+}
+
+// CreateFolder creates a folder at the root of the caller's folder tree.
+func (c *Client) CreateFolder(ctx context.Context, form FolderForm) (*FolderModel, error) {
+	var resp FolderModel
+	if err := c.do(ctx, http.MethodPost, "folders/", nil, form, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListFolders returns all folders owned by the caller.
+func (c *Client) ListFolders(ctx context.Context) ([]FolderModel, error) {
+	var resp []FolderModel
+	if err := c.do(ctx, http.MethodGet, "folders/", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetFolder fetches a folder by identifier.
+func (c *Client) GetFolder(ctx context.Context, id string) (*FolderModel, error) {
+	var resp FolderModel
+	path := fmt.Sprintf("folders/%s", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.ID == "" {
+		return nil, ErrNotFound
+	}
+
+	return &resp, nil
+}
+
+// UpdateFolderName renames a folder.
+func (c *Client) UpdateFolderName(ctx context.Context, id string, form FolderForm) error {
+	path := fmt.Sprintf("folders/%s/update", url.PathEscape(id))
+	return c.do(ctx, http.MethodPost, path, nil, form, nil)
+}
+
+// UpdateFolderParent moves a folder below parentID, or to the root when parentID is nil.
+func (c *Client) UpdateFolderParent(ctx context.Context, id string, parentID *string) error {
+	body := map[string]any{
+		"parent_id": parentID,
+	}
+
+	path := fmt.Sprintf("folders/%s/update/parent", url.PathEscape(id))
+	return c.do(ctx, http.MethodPost, path, nil, body, nil)
+}
+
+// UpdateFolderExpanded sets whether a folder is shown expanded in the sidebar.
+func (c *Client) UpdateFolderExpanded(ctx context.Context, id string, expanded bool) error {
+	body := map[string]any{
+		"is_expanded": expanded,
+	}
+
+	path := fmt.Sprintf("folders/%s/update/expanded", url.PathEscape(id))
+	return c.do(ctx, http.MethodPost, path, nil, body, nil)
+}
+
+// DeleteFolder removes a folder by identifier.
+func (c *Client) DeleteFolder(ctx context.Context, id string) error {
+	path := fmt.Sprintf("folders/%s", url.PathEscape(id))
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// FunctionResponse captures details returned by the function endpoints. Content is only
+// populated when a single function is requested.
+type FunctionResponse struct {
+	ID        string         `json:"id"`
+	UserID    string         `json:"user_id"`
+	Name      string         `json:"name"`
+	Type      string         `json:"type"`
+	Content   string         `json:"content,omitempty"`
+	Meta      map[string]any `json:"meta"`
+	IsActive  bool           `json:"is_active"`
+	IsGlobal  bool           `json:"is_global"`
+	CreatedAt int64          `json:"created_at"`
+	UpdatedAt int64          `json:"updated_at"`
+}
+
+// ListFunctions returns the installed functions.
+func (c *Client) ListFunctions(ctx context.Context) ([]FunctionResponse, error) {
+	var resp []FunctionResponse
+	if err := c.do(ctx, http.MethodGet, "functions/", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetFunction obtains a function, including its source, by identifier.
+func (c *Client) GetFunction(ctx context.Context, id string) (*FunctionResponse, error) {
+	var resp *FunctionResponse
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("functions/id/%s", url.PathEscape(id)), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, ErrNotFound
+	}
+
+	return resp, nil
+}
+
+// GetFunctionValvesSpec returns the JSON schema of a function's valves, or nil when the
+// function declares none.
+func (c *Client) GetFunctionValvesSpec(ctx context.Context, id string) (map[string]any, error) {
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("functions/id/%s/valves/spec", url.PathEscape(id)), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
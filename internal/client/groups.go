@@ -20,6 +20,9 @@ type GroupUpdateForm struct {
 	Permissions map[string]any `json:"permissions,omitempty"`
 	Meta        map[string]any `json:"meta,omitempty"`
 	Data        map[string]any `json:"data,omitempty"`
+	// AdminIDs replaces the group administrators. It is sent as null when nil, which
+	// Open WebUI ignores, so an empty non-nil slice is needed to remove all admins.
+	AdminIDs []string `json:"admin_ids"`
 }
 
 // GroupResponse captures group details returned by the API.
@@ -8,10 +8,11 @@ import (
 )
 
 // KnowledgeForm models the payload for creating or updating knowledge records.
+// AccessControl is always sent, as null makes the record public and {} private.
 type KnowledgeForm struct {
 	Name          string         `json:"name"`
 	Description   string         `json:"description"`
-	AccessControl map[string]any `json:"access_control,omitempty"`
+	AccessControl map[string]any `json:"access_control"`
 	Data          map[string]any `json:"data,omitempty"`
 	Meta          map[string]any `json:"meta,omitempty"`
 }
@@ -117,3 +118,41 @@ func (c *Client) DeleteKnowledge(ctx context.Context, id string) error {
 	path := fmt.Sprintf("knowledge/%s/delete", url.PathEscape(id))
 	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
 }
+
+// knowledgeFileIDForm is the payload shared by the knowledge file endpoints.
+type knowledgeFileIDForm struct {
+	FileID string `json:"file_id"`
+}
+
+// AddKnowledgeFile attaches an uploaded file to a knowledge record.
+func (c *Client) AddKnowledgeFile(ctx context.Context, id, fileID string) (*KnowledgeFilesResponse, error) {
+	var resp KnowledgeFilesResponse
+	path := fmt.Sprintf("knowledge/%s/file/add", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodPost, path, nil, knowledgeFileIDForm{FileID: fileID}, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// RemoveKnowledgeFile detaches a file from a knowledge record.
+func (c *Client) RemoveKnowledgeFile(ctx context.Context, id, fileID string) (*KnowledgeFilesResponse, error) {
+	var resp KnowledgeFilesResponse
+	path := fmt.Sprintf("knowledge/%s/file/remove", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodPost, path, nil, knowledgeFileIDForm{FileID: fileID}, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ResetKnowledge clears the vector collection and file associations of a knowledge record.
+func (c *Client) ResetKnowledge(ctx context.Context, id string) (*KnowledgeResponse, error) {
+	var resp KnowledgeResponse
+	path := fmt.Sprintf("knowledge/%s/reset", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MemoryForm represents the payload for adding or updating a memory.
+type MemoryForm struct {
+	Content string `json:"content"`
+}
+
+// MemoryModel is returned by the memory endpoints.
+type MemoryModel struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Content   string `json:"content"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// MemoryQueryForm represents the payload for a similarity search over memories.
+type MemoryQueryForm struct {
+	Content string `json:"content"`
+	K       int64  `json:"k"`
+}
+
+// MemoryQueryResult is the vector search result returned by the memory query endpoint.
+// Every field holds one list per query; the memory endpoint always sends a single query.
+type MemoryQueryResult struct {
+	IDs       [][]string         `json:"ids"`
+	Documents [][]string         `json:"documents"`
+	Metadatas [][]map[string]any `json:"metadatas"`
+	Distances [][]float64        `json:"distances"`
+}
+
+// AddMemory stores a new memory for the caller.
+func (c *Client) AddMemory(ctx context.Context, form MemoryForm) (*MemoryModel, error) {
+	var resp MemoryModel
+	if err := c.do(ctx, http.MethodPost, "memories/add", nil, form, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListMemories returns all memories of the caller.
+func (c *Client) ListMemories(ctx context.Context) ([]MemoryModel, error) {
+	var resp []MemoryModel
+	if err := c.do(ctx, http.MethodGet, "memories/", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetMemory finds a memory of the caller by identifier.
+func (c *Client) GetMemory(ctx context.Context, id string) (*MemoryModel, error) {
+	memories, err := c.ListMemories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range memories {
+		if memories[i].ID == id {
+			return &memories[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// UpdateMemory replaces the content of a memory.
+func (c *Client) UpdateMemory(ctx context.Context, id string, form MemoryForm) (*MemoryModel, error) {
+	var resp MemoryModel
+	path := fmt.Sprintf("memories/%s/update", url.PathEscape(id))
+	if err := c.do(ctx, http.MethodPost, path, nil, form, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteMemory removes a memory by identifier.
+func (c *Client) DeleteMemory(ctx context.Context, id string) error {
+	path := fmt.Sprintf("memories/%s", url.PathEscape(id))
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// QueryMemories returns the k memories most similar to content.
+func (c *Client) QueryMemories(ctx context.Context, form MemoryQueryForm) (*MemoryQueryResult, error) {
+	var resp MemoryQueryResult
+	if err := c.do(ctx, http.MethodPost, "memories/query", nil, form, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
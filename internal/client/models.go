@@ -7,6 +7,7 @@ import (
 )
 
 // ModelForm represents the payload for creating or updating models.
+// AccessControl is always sent, as null makes the record public and {} private.
 type ModelForm struct {
 	ID            string         `json:"id"`
 	Name          string         `json:"name"`
@@ -14,7 +15,7 @@ type ModelForm struct {
 	Params        map[string]any `json:"params"`
 	BaseModelID   *string        `json:"base_model_id,omitempty"`
 	IsActive      *bool          `json:"is_active,omitempty"`
-	AccessControl map[string]any `json:"access_control,omitempty"`
+	AccessControl map[string]any `json:"access_control"`
 }
 
 // ModelResponse captures details returned by the model endpoints.
@@ -41,6 +42,27 @@ func (c *Client) CreateModel(ctx context.Context, form ModelForm) (*ModelRespons
 	return &resp, nil
 }
 
+// ListModels returns the workspace models visible to the caller.
+func (c *Client) ListModels(ctx context.Context) ([]ModelResponse, error) {
+	var resp []ModelResponse
+	if err := c.do(ctx, http.MethodGet, "models/", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ListBaseModels returns the base models the administrator has configured, which
+// wrap the models provided by the Open WebUI connections.
+func (c *Client) ListBaseModels(ctx context.Context) ([]ModelResponse, error) {
+	var resp []ModelResponse
+	if err := c.do(ctx, http.MethodGet, "models/base", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // GetModel obtains model details by identifier.
 func (c *Client) GetModel(ctx context.Context, id string) (*ModelResponse, error) {
 	var resp ModelResponse
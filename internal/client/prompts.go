@@ -23,11 +23,12 @@ func promptPathSegment(command string) string {
 }
 
 // PromptForm represents the payload for managing prompt definitions.
+// AccessControl is always sent, as null makes the record public and {} private.
 type PromptForm struct {
 	Command       string         `json:"command"`
 	Title         string         `json:"title"`
 	Content       string         `json:"content"`
-	AccessControl map[string]any `json:"access_control,omitempty"`
+	AccessControl map[string]any `json:"access_control"`
 }
 
 // PromptModel is returned by the prompt endpoints.
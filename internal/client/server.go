@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// ServerConfig is the public configuration Open WebUI serves to its frontend.
+type ServerConfig struct {
+	Name          string         `json:"name"`
+	Version       string         `json:"version"`
+	DefaultLocale string         `json:"default_locale"`
+	Features      map[string]any `json:"features"`
+	OAuth         struct {
+		Providers map[string]any `json:"providers"`
+	} `json:"oauth"`
+}
+
+// GetServerConfig returns the server name, version, features and sign-in options from
+// /api/config, which lives beside the versioned API rather than under it.
+func (c *Client) GetServerConfig(ctx context.Context) (*ServerConfig, error) {
+	root := *c
+	root.baseURL = strings.TrimSuffix(c.baseURL, "/v1")
+
+	var resp ServerConfig
+	if err := root.do(ctx, http.MethodGet, "config", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
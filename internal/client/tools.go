@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ToolResponse captures details returned by the tool endpoints. Content and Specs are
+// only populated when a single tool is requested.
+type ToolResponse struct {
+	ID            string           `json:"id"`
+	UserID        string           `json:"user_id"`
+	Name          string           `json:"name"`
+	Content       string           `json:"content,omitempty"`
+	Specs         []map[string]any `json:"specs,omitempty"`
+	Meta          map[string]any   `json:"meta"`
+	AccessControl map[string]any   `json:"access_control,omitempty"`
+	CreatedAt     int64            `json:"created_at"`
+	UpdatedAt     int64            `json:"updated_at"`
+}
+
+// ListTools returns the tools visible to the caller.
+func (c *Client) ListTools(ctx context.Context) ([]ToolResponse, error) {
+	var resp []ToolResponse
+	if err := c.do(ctx, http.MethodGet, "tools/", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetTool obtains a tool, including its source and specs, by identifier.
+func (c *Client) GetTool(ctx context.Context, id string) (*ToolResponse, error) {
+	var resp *ToolResponse
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("tools/id/%s", url.PathEscape(id)), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, ErrNotFound
+	}
+
+	return resp, nil
+}
+
+// GetToolValvesSpec returns the JSON schema of a tool's valves, or nil when the tool
+// declares none.
+func (c *Client) GetToolValvesSpec(ctx context.Context, id string) (map[string]any, error) {
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("tools/id/%s/valves/spec", url.PathEscape(id)), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -46,6 +46,25 @@ func (c *Client) SearchUsers(ctx context.Context, query string, limit int) ([]Us
 	return resp.Users, nil
 }
 
+// ListUsers returns one page of the users matching query, along with the total number
+// of matches. Pages start at 1.
+func (c *Client) ListUsers(ctx context.Context, query string, page int) ([]User, int, error) {
+	values := url.Values{}
+	if query != "" {
+		values.Set("query", query)
+	}
+	if page > 0 {
+		values.Set("page", fmt.Sprintf("%d", page))
+	}
+
+	var resp listUsersResponse
+	if err := c.do(ctx, http.MethodGet, "users/", values, nil, &resp); err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Users, resp.Total, nil
+}
+
 // GetUser retrieves a user by identifier.
 func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 	var resp User
@@ -56,3 +75,60 @@ func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 
 	return &resp, nil
 }
+
+// GetDefaultPermissions returns the permissions every user receives, keyed by category.
+// Open WebUI only exposes them to administrators.
+func (c *Client) GetDefaultPermissions(ctx context.Context) (map[string]any, error) {
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodGet, "users/default/permissions", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UserSettings holds the settings document of a user. Open WebUI merges updates at the
+// top level, so sending ui replaces the whole ui object but keeps other sections.
+type UserSettings struct {
+	UI map[string]any `json:"ui"`
+}
+
+// GetUserSettings returns the settings of the caller.
+func (c *Client) GetUserSettings(ctx context.Context) (*UserSettings, error) {
+	var resp UserSettings
+	if err := c.do(ctx, http.MethodGet, "users/user/settings", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateUserSettings stores the settings of the caller.
+func (c *Client) UpdateUserSettings(ctx context.Context, settings UserSettings) (*UserSettings, error) {
+	var resp UserSettings
+	if err := c.do(ctx, http.MethodPost, "users/user/settings/update", nil, settings, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetUserInfo returns the free-form info document of the caller.
+func (c *Client) GetUserInfo(ctx context.Context) (map[string]any, error) {
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodGet, "users/user/info", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UpdateUserInfo merges info into the info document of the caller.
+func (c *Client) UpdateUserInfo(ctx context.Context, info map[string]any) (map[string]any, error) {
+	var resp map[string]any
+	if err := c.do(ctx, http.MethodPost, "users/user/info/update", nil, info, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -3,14 +3,253 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
 )
 
+// Visibility levels of access-controlled records.
+const (
+	visibilityPublic     = "public"
+	visibilityPrivate    = "private"
+	visibilityRestricted = "restricted"
+)
+
+// accessGrantPaths lists the attributes that grant access to groups or users.
+var accessGrantPaths = []path.Path{
+	path.Root("read_groups"),
+	path.Root("write_groups"),
+	path.Root("read_group_ids"),
+	path.Root("write_group_ids"),
+	path.Root("read_users"),
+	path.Root("write_users"),
+}
+
+// accessControlModel holds the access grant attributes shared by access-controlled
+// resources and data sources.
+type accessControlModel struct {
+	ReadGroups    types.Set    `tfsdk:"read_groups"`
+	WriteGroups   types.Set    `tfsdk:"write_groups"`
+	ReadGroupIDs  types.Set    `tfsdk:"read_group_ids"`
+	WriteGroupIDs types.Set    `tfsdk:"write_group_ids"`
+	ReadUsers     types.Set    `tfsdk:"read_users"`
+	WriteUsers    types.Set    `tfsdk:"write_users"`
+	Visibility    types.String `tfsdk:"visibility"`
+}
+
+// usesGroupIDs reports whether the grants are managed through read_group_ids and
+// write_group_ids rather than the name-based lists.
+func (m accessControlModel) usesGroupIDs() bool {
+	return !m.ReadGroupIDs.IsNull() || !m.WriteGroupIDs.IsNull()
+}
+
+// expandAccessControl builds the access_control document of a plan. Group IDs are used
+// verbatim, group names are resolved against the current groups and users are resolved
+// with the given user match mode.
+func expandAccessControl(ctx context.Context, apiClient *client.Client, access accessControlModel, match string, diags *diag.Diagnostics) map[string]any {
+	var readIDs, writeIDs []string
+	if access.usesGroupIDs() {
+		readIDs = uniqueStrings(expandStringSet(ctx, access.ReadGroupIDs, path.Root("read_group_ids"), diags))
+		writeIDs = uniqueStrings(expandStringSet(ctx, access.WriteGroupIDs, path.Root("write_group_ids"), diags))
+	} else {
+		readNames := expandStringSet(ctx, access.ReadGroups, path.Root("read_groups"), diags)
+		writeNames := expandStringSet(ctx, access.WriteGroups, path.Root("write_groups"), diags)
+		readIDs = resolveGroupNamesToIDs(ctx, apiClient, readNames, path.Root("read_groups"), diags)
+		writeIDs = resolveGroupNamesToIDs(ctx, apiClient, writeNames, path.Root("write_groups"), diags)
+	}
+
+	readUsers := expandStringSet(ctx, access.ReadUsers, path.Root("read_users"), diags)
+	writeUsers := expandStringSet(ctx, access.WriteUsers, path.Root("write_users"), diags)
+	readUserIDs := uniqueStrings(resolveUserIDs(ctx, apiClient, readUsers, match, path.Root("read_users"), diags))
+	writeUserIDs := uniqueStrings(resolveUserIDs(ctx, apiClient, writeUsers, match, path.Root("write_users"), diags))
+
+	switch access.Visibility.ValueString() {
+	case visibilityPublic:
+		return nil
+	case visibilityPrivate:
+		return map[string]any{}
+	case visibilityRestricted:
+		// Restricted records without grants are only visible to their owner.
+		if control := buildAccessControl(readIDs, writeIDs, readUserIDs, writeUserIDs); control != nil {
+			return control
+		}
+		return map[string]any{}
+	default:
+		return buildAccessControl(readIDs, writeIDs, readUserIDs, writeUserIDs)
+	}
+}
+
+// flattenAccessControl reports the grants of an access_control document. When prior
+// manages group IDs they are reported as is and the name-based sets are null. Otherwise
+// the IDs are resolved to group names. Users are reported with the identifiers used in
+// prior where possible and by email address otherwise.
+func flattenAccessControl(ctx context.Context, apiClient *client.Client, access map[string]any, prior accessControlModel, diags *diag.Diagnostics) accessControlModel {
+	readIDs := extractGroupIDsFromAccessControl(access, "read")
+	writeIDs := extractGroupIDsFromAccessControl(access, "write")
+
+	state := accessControlModel{
+		ReadGroups:    types.SetNull(types.StringType),
+		WriteGroups:   types.SetNull(types.StringType),
+		ReadGroupIDs:  types.SetNull(types.StringType),
+		WriteGroupIDs: types.SetNull(types.StringType),
+	}
+
+	if prior.usesGroupIDs() {
+		priorRead := expandStringSet(ctx, prior.ReadGroupIDs, path.Root("read_group_ids"), diags)
+		readIDs = withoutImpliedReaders(readIDs, writeIDs, priorRead)
+
+		state.ReadGroupIDs = flattenGrantSet(ctx, readIDs, prior.ReadGroupIDs, diags)
+		state.WriteGroupIDs = flattenGrantSet(ctx, writeIDs, prior.WriteGroupIDs, diags)
+	} else {
+		readNames, readDiags := fetchGroupNamesForIDs(ctx, apiClient, readIDs)
+		diags.Append(readDiags...)
+		writeNames, writeDiags := fetchGroupNamesForIDs(ctx, apiClient, writeIDs)
+		diags.Append(writeDiags...)
+
+		readList, readListDiags := flattenStringSet(ctx, readNames)
+		diags.Append(readListDiags...)
+		writeList, writeListDiags := flattenStringSet(ctx, writeNames)
+		diags.Append(writeListDiags...)
+
+		state.ReadGroups = readList
+		state.WriteGroups = writeList
+	}
+
+	priorReadUsers := expandStringSet(ctx, prior.ReadUsers, path.Root("read_users"), diags)
+	priorWriteUsers := expandStringSet(ctx, prior.WriteUsers, path.Root("write_users"), diags)
+	labels := labelAccessUsers(ctx, apiClient, access, append(append([]string{}, priorReadUsers...), priorWriteUsers...), diags)
+
+	readUsers := labelsForIDs(extractUserIDsFromAccessControl(access, "read"), labels)
+	writeUsers := labelsForIDs(extractUserIDsFromAccessControl(access, "write"), labels)
+	readUsers = withoutImpliedReaders(readUsers, writeUsers, priorReadUsers)
+
+	state.ReadUsers = flattenGrantSet(ctx, readUsers, prior.ReadUsers, diags)
+	state.WriteUsers = flattenGrantSet(ctx, writeUsers, prior.WriteUsers, diags)
+	state.Visibility = flattenVisibility(access, prior.Visibility)
+
+	return state
+}
+
+// flattenVisibility derives the visibility of an access_control document. Private and
+// restricted records both carry a document, so prior's choice between them is kept.
+func flattenVisibility(access map[string]any, prior types.String) types.String {
+	if access == nil {
+		return types.StringValue(visibilityPublic)
+	}
+
+	switch prior.ValueString() {
+	case visibilityPrivate, visibilityRestricted:
+		return prior
+	}
+
+	for _, section := range []string{"read", "write"} {
+		if len(extractGroupIDsFromAccessControl(access, section)) > 0 || len(extractUserIDsFromAccessControl(access, section)) > 0 {
+			return types.StringValue(visibilityRestricted)
+		}
+	}
+
+	return types.StringValue(visibilityPrivate)
+}
+
+// exposeAccessControl fills in the group IDs and users of an access_control document for
+// data sources, which report them next to the group names.
+func (m *accessControlModel) exposeAccessControl(ctx context.Context, apiClient *client.Client, access map[string]any, diags *diag.Diagnostics) {
+	readIDs, readDiags := flattenStringSet(ctx, extractGroupIDsFromAccessControl(access, "read"))
+	diags.Append(readDiags...)
+	writeIDs, writeDiags := flattenStringSet(ctx, extractGroupIDsFromAccessControl(access, "write"))
+	diags.Append(writeDiags...)
+
+	readUsers, readUserDiags := fetchUsernamesForIDs(ctx, apiClient, extractUserIDsFromAccessControl(access, "read"))
+	diags.Append(readUserDiags...)
+	writeUsers, writeUserDiags := fetchUsernamesForIDs(ctx, apiClient, extractUserIDsFromAccessControl(access, "write"))
+	diags.Append(writeUserDiags...)
+
+	readUserList, readListDiags := flattenStringSet(ctx, readUsers)
+	diags.Append(readListDiags...)
+	writeUserList, writeListDiags := flattenStringSet(ctx, writeUsers)
+	diags.Append(writeListDiags...)
+
+	m.ReadGroupIDs = readIDs
+	m.WriteGroupIDs = writeIDs
+	m.ReadUsers = readUserList
+	m.WriteUsers = writeUserList
+	m.Visibility = flattenVisibility(access, types.StringNull())
+}
+
+// withoutImpliedReaders drops writers from readers unless prior lists them as readers.
+// Writers are always granted read access, so they only count as readers when explicit.
+func withoutImpliedReaders(readers, writers, priorReaders []string) []string {
+	implied, _ := diffStringSets(priorReaders, writers)
+	kept, _ := diffStringSets(implied, readers)
+	return kept
+}
+
+// labelAccessUsers maps the user IDs of an access_control document to the identifiers
+// used in prior. Users that prior does not mention are labelled with their email address.
+func labelAccessUsers(ctx context.Context, apiClient *client.Client, access map[string]any, prior []string, diags *diag.Diagnostics) map[string]string {
+	ids := uniqueStrings(append(extractUserIDsFromAccessControl(access, "read"), extractUserIDsFromAccessControl(access, "write")...))
+
+	labels := make(map[string]string, len(ids))
+	for _, id := range ids {
+		if slices.Contains(prior, id) {
+			labels[id] = id
+			continue
+		}
+
+		user, err := apiClient.GetUser(ctx, id)
+		if err != nil {
+			if err == client.ErrNotFound {
+				labels[id] = id
+				continue
+			}
+			diags.AddError("Fetch user failed", fmt.Sprintf("Failed to retrieve user %s: %v", id, err))
+			continue
+		}
+
+		labels[id] = user.Email
+		for _, identifier := range prior {
+			if userMatchesExactly(*user, identifier) {
+				labels[id] = identifier
+				break
+			}
+		}
+		if labels[id] == "" {
+			labels[id] = id
+		}
+	}
+
+	return labels
+}
+
+// labelsForIDs returns the labels of ids, skipping IDs without a label.
+func labelsForIDs(ids []string, labels map[string]string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if label, ok := labels[id]; ok {
+			result = append(result, label)
+		}
+	}
+
+	return uniqueStrings(result)
+}
+
+// flattenGrantSet converts values to a set. An empty result is null unless prior is
+// an empty set.
+func flattenGrantSet(ctx context.Context, values []string, prior types.Set, diags *diag.Diagnostics) types.Set {
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.SetNull(types.StringType)
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(nonNilStrings(values)))
+	diags.Append(setDiags...)
+	return set
+}
+
 func resolveGroupNamesToIDs(ctx context.Context, apiClient *client.Client, names []string, attribute path.Path, diags *diag.Diagnostics) []string {
 	if len(names) == 0 {
 		return nil
@@ -113,32 +352,51 @@ func fetchGroupNamesForIDs(ctx context.Context, apiClient *client.Client, ids []
 	return uniqueStrings(names), diags
 }
 
-func buildAccessControl(readIDs, writeIDs []string) map[string]any {
-	if len(readIDs) == 0 && len(writeIDs) == 0 {
+func buildAccessControl(readIDs, writeIDs, readUserIDs, writeUserIDs []string) map[string]any {
+	if len(readIDs) == 0 && len(writeIDs) == 0 && len(readUserIDs) == 0 && len(writeUserIDs) == 0 {
 		return nil
 	}
 
-	mergedRead := uniqueStrings(append(readIDs, writeIDs...))
+	mergedRead := uniqueStrings(append(append([]string{}, readIDs...), writeIDs...))
+	mergedReadUsers := uniqueStrings(append(append([]string{}, readUserIDs...), writeUserIDs...))
 
 	control := make(map[string]any)
-	if len(mergedRead) > 0 {
+	if len(mergedRead) > 0 || len(mergedReadUsers) > 0 {
 		control["read"] = map[string]any{
-			"group_ids": mergedRead,
-			"user_ids":  []string{},
+			"group_ids": nonNilStrings(mergedRead),
+			"user_ids":  nonNilStrings(mergedReadUsers),
 		}
 	}
 
-	if len(writeIDs) > 0 {
+	if len(writeIDs) > 0 || len(writeUserIDs) > 0 {
 		control["write"] = map[string]any{
-			"group_ids": writeIDs,
-			"user_ids":  []string{},
+			"group_ids": nonNilStrings(writeIDs),
+			"user_ids":  nonNilStrings(writeUserIDs),
 		}
 	}
 
 	return control
 }
 
+// nonNilStrings returns values, or an empty slice so it is encoded as [] rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
 func extractGroupIDsFromAccessControl(access map[string]any, section string) []string {
+	return extractAccessControlIDs(access, section, "group_ids")
+}
+
+func extractUserIDsFromAccessControl(access map[string]any, section string) []string {
+	return extractAccessControlIDs(access, section, "user_ids")
+}
+
+// extractAccessControlIDs returns the IDs stored under key in a section of access.
+func extractAccessControlIDs(access map[string]any, section, key string) []string {
 	if access == nil {
 		return nil
 	}
@@ -153,7 +411,7 @@ func extractGroupIDsFromAccessControl(access map[string]any, section string) []s
 		return nil
 	}
 
-	idsRaw, ok := sectionMap["group_ids"]
+	idsRaw, ok := sectionMap[key]
 	if !ok || idsRaw == nil {
 		return nil
 	}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithoutImpliedReaders(t *testing.T) {
+	tests := []struct {
+		name         string
+		readers      []string
+		writers      []string
+		priorReaders []string
+		want         []string
+	}{
+		{name: "no writers", readers: []string{"a", "b"}, want: []string{"a", "b"}},
+		{name: "writer dropped from readers", readers: []string{"a", "w"}, writers: []string{"w"}, want: []string{"a"}},
+		{name: "explicit reader that also writes is kept", readers: []string{"a", "w"}, writers: []string{"w"}, priorReaders: []string{"w"}, want: []string{"a", "w"}},
+		{name: "only writers", readers: []string{"w1", "w2"}, writers: []string{"w1", "w2"}, want: nil},
+		{name: "prior reader that no longer writes", readers: []string{"a", "r"}, writers: []string{"w"}, priorReaders: []string{"r"}, want: []string{"a", "r"}},
+		{name: "writer not in readers", readers: []string{"a"}, writers: []string{"w"}, want: []string{"a"}},
+		{name: "empty", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withoutImpliedReaders(tt.readers, tt.writers, tt.priorReaders)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("withoutImpliedReaders(%v, %v, %v) = %v, want %v", tt.readers, tt.writers, tt.priorReaders, got, tt.want)
+			}
+		})
+	}
+}
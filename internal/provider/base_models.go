@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+// baseModelCatalog caches the base models Open WebUI offers, as reported by /models/base
+// and /models/, for the lifetime of the provider.
+type baseModelCatalog struct {
+	mu     sync.Mutex
+	loaded bool
+	models []client.ModelResponse
+}
+
+// load returns the base models sorted by ID, fetching them on first use.
+func (c *baseModelCatalog) load(ctx context.Context, apiClient *client.Client) ([]client.ModelResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return c.models, nil
+	}
+
+	models, err := listBaseModels(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c.models = models
+	c.loaded = true
+	return models, nil
+}
+
+// listBaseModels merges the configured base models with the workspace models that are
+// not built on another model, deduplicated and sorted by ID.
+func listBaseModels(ctx context.Context, apiClient *client.Client) ([]client.ModelResponse, error) {
+	base, err := apiClient.ListBaseModels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading base models: %w", err)
+	}
+
+	workspace, err := apiClient.ListModels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading models: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(base))
+	var models []client.ModelResponse
+	for _, model := range append(base, workspace...) {
+		if model.BaseModelID != nil && *model.BaseModelID != "" {
+			continue
+		}
+		if _, ok := seen[model.ID]; ok {
+			continue
+		}
+		seen[model.ID] = struct{}{}
+		models = append(models, model)
+	}
+
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+	return models, nil
+}
+
+// baseModelOwner returns the connection that provides a base model, such as `openai` or
+// `ollama`, when Open WebUI recorded it in the model metadata.
+func baseModelOwner(model client.ModelResponse) (string, bool) {
+	for _, key := range []string{"owned_by", "connection_type"} {
+		if value, ok := toStringValue(model.Meta[key]); ok && value != "" {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// validateBaseModelID reports an attribute error when id is not a known base model. A
+// catalog that cannot be read only produces a warning.
+func validateBaseModelID(ctx context.Context, apiClient *client.Client, catalog *baseModelCatalog, id string, attribute path.Path, diags *diag.Diagnostics) {
+	if id == "" || catalog == nil {
+		return
+	}
+
+	models, err := catalog.load(ctx, apiClient)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to verify base model",
+			fmt.Sprintf("Listing base models failed, so base_model_id is sent without validation: %v", err),
+		)
+		return
+	}
+
+	ids := make([]string, 0, len(models))
+	for _, model := range models {
+		if model.ID == id {
+			return
+		}
+		ids = append(ids, model.ID)
+	}
+
+	available := "none"
+	if len(ids) > 0 {
+		available = strings.Join(ids, ", ")
+	}
+	diags.AddAttributeError(
+		attribute,
+		"Unknown base model",
+		fmt.Sprintf("Open WebUI does not offer a base model %q. Available base models: %s.", id, available),
+	)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &baseModelsDataSource{}
+var _ datasource.DataSourceWithConfigure = &baseModelsDataSource{}
+
+// baseModelsDataSource lists the base models workspace models can be built on.
+type baseModelsDataSource struct {
+	client *client.Client
+}
+
+// baseModelsDataSourceModel maps the data source schema data.
+type baseModelsDataSourceModel struct {
+	NameRegex types.String           `tfsdk:"name_regex"`
+	OwnedBy   types.String           `tfsdk:"owned_by"`
+	IsActive  types.Bool             `tfsdk:"is_active"`
+	IDs       types.List             `tfsdk:"ids"`
+	Models    []baseModelsEntryModel `tfsdk:"models"`
+}
+
+// baseModelsEntryModel describes a listed base model.
+type baseModelsEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	OwnedBy     types.String `tfsdk:"owned_by"`
+	Description types.String `tfsdk:"description"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	UserID      types.String `tfsdk:"user_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewBaseModelsDataSource constructs a new base models data source.
+func NewBaseModelsDataSource() datasource.DataSource {
+	return &baseModelsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *baseModelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_base_models"
+}
+
+// Schema describes the base models data source schema.
+func (d *baseModelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Regular expression that the model name or ID must match.",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"owned_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return models provided by this connection, such as `openai` or `ollama` (case-insensitive).",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return active (`true`) or inactive (`false`) models.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Identifiers of the matching models, sorted.",
+			},
+			"models": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching base models, sorted by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Model identifier, usable as `base_model_id` of `openwebui_model`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name.",
+						},
+						"owned_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "Connection that provides the model, when Open WebUI recorded it.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description from the model metadata.",
+						},
+						"is_active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the model is active.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who configured the model.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date formatted as YYYY-MM-DD.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last update date formatted as YYYY-MM-DD.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *baseModelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read lists the base models and applies the filters.
+func (d *baseModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the base models data source.")
+		return
+	}
+
+	var config baseModelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pattern *regexp.Regexp
+	if value := config.NameRegex.ValueString(); value != "" {
+		compiled, err := regexp.Compile(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+		pattern = compiled
+	}
+
+	// Read the catalogue directly rather than through the provider cache, which only
+	// serves plan-time validation.
+	models, err := listBaseModels(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("List base models failed", err.Error())
+		return
+	}
+
+	ids := []string{}
+	config.Models = []baseModelsEntryModel{}
+	for _, model := range models {
+		if pattern != nil && !pattern.MatchString(model.Name) && !pattern.MatchString(model.ID) {
+			continue
+		}
+		if !config.IsActive.IsNull() && config.IsActive.ValueBool() != model.IsActive {
+			continue
+		}
+
+		owner, hasOwner := baseModelOwner(model)
+		if value := config.OwnedBy.ValueString(); value != "" && !strings.EqualFold(owner, value) {
+			continue
+		}
+
+		entry := baseModelsEntryModel{
+			ID:          types.StringValue(model.ID),
+			Name:        types.StringValue(model.Name),
+			OwnedBy:     types.StringNull(),
+			Description: types.StringNull(),
+			IsActive:    types.BoolValue(model.IsActive),
+			UserID:      types.StringValue(model.UserID),
+			CreatedAt:   formatDateValue(model.CreatedAt),
+			UpdatedAt:   formatDateValue(model.UpdatedAt),
+		}
+		if hasOwner {
+			entry.OwnedBy = types.StringValue(owner)
+		}
+		if description, ok := toStringValue(model.Meta["description"]); ok {
+			entry.Description = types.StringValue(description)
+		}
+
+		ids = append(ids, model.ID)
+		config.Models = append(config.Models, entry)
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idList
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
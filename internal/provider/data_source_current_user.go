@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &currentUserDataSource{}
+var _ datasource.DataSourceWithConfigure = &currentUserDataSource{}
+
+// currentUserDataSource exposes the identity the provider authenticates as.
+type currentUserDataSource struct {
+	client *client.Client
+}
+
+// currentUserDataSourceModel maps the data source schema data.
+type currentUserDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	Name        types.String `tfsdk:"name"`
+	Role        types.String `tfsdk:"role"`
+	IsAdmin     types.Bool   `tfsdk:"is_admin"`
+	Permissions types.Map    `tfsdk:"permissions"`
+}
+
+// NewCurrentUserDataSource constructs a new current user data source.
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &currentUserDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema describes the current user data source schema.
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the user the provider token belongs to.",
+			},
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email address of the user.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the user.",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role of the user: `admin`, `user` or `pending`.",
+			},
+			"is_admin": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user is an administrator, as required to manage groups, users and permissions.",
+			},
+			"permissions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: groupPermissionFlagsType,
+				Description: "Effective permission flags of the user by category, as reported by Open WebUI.",
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *currentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read fetches the session user from /auths/.
+func (d *currentUserDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the current user data source.")
+		return
+	}
+
+	user, err := d.client.GetSessionUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read current user failed", err.Error())
+		return
+	}
+
+	state := currentUserDataSourceModel{
+		ID:      types.StringValue(user.ID),
+		Email:   types.StringValue(user.Email),
+		Name:    types.StringValue(user.Name),
+		Role:    types.StringValue(user.Role),
+		IsAdmin: types.BoolValue(user.Role == "admin"),
+	}
+
+	permissions, diags := types.MapValueFrom(ctx, groupPermissionFlagsType, permissionFlags(ctx, user.Permissions))
+	resp.Diagnostics.Append(diags...)
+	state.Permissions = permissions
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
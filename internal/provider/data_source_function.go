@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &functionDataSource{}
+var _ datasource.DataSourceWithConfigure = &functionDataSource{}
+
+// functionDataSource looks up a single function.
+type functionDataSource struct {
+	client *client.Client
+}
+
+// functionDataSourceModel maps the data source schema data.
+type functionDataSourceModel struct {
+	ID             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	Type           types.String         `tfsdk:"type"`
+	IsActive       types.Bool           `tfsdk:"is_active"`
+	IsGlobal       types.Bool           `tfsdk:"is_global"`
+	Description    types.String         `tfsdk:"description"`
+	Content        types.String         `tfsdk:"content"`
+	ManifestJSON   jsontypes.Normalized `tfsdk:"manifest_json"`
+	ValvesSpecJSON jsontypes.Normalized `tfsdk:"valves_spec_json"`
+	UserID         types.String         `tfsdk:"user_id"`
+	CreatedAt      types.String         `tfsdk:"created_at"`
+	UpdatedAt      types.String         `tfsdk:"updated_at"`
+}
+
+// NewFunctionDataSource constructs a new function data source.
+func NewFunctionDataSource() datasource.DataSource {
+	return &functionDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *functionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function"
+}
+
+// Schema describes the function data source schema.
+func (d *functionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the function to retrieve. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the function to retrieve, compared case-insensitively.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Function type: `filter`, `action` or `pipe`.",
+			},
+			"is_active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the function is enabled.",
+			},
+			"is_global": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the filter or action applies to every model instead of only the models that select it.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description from the function metadata.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "Python source of the function.",
+			},
+			"manifest_json": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Manifest parsed from the function's frontmatter, such as its author and version, as JSON.",
+			},
+			"valves_spec_json": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON schema of the function's valves, or null when it declares none.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the user who owns the function.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date formatted as YYYY-MM-DD.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date formatted as YYYY-MM-DD.",
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *functionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read looks up the function and its valves schema.
+func (d *functionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the function data source.")
+		return
+	}
+
+	var config functionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	if id == "" {
+		functions, err := d.client.ListFunctions(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("List functions failed", err.Error())
+			return
+		}
+
+		var ids []string
+		for _, function := range functions {
+			if strings.EqualFold(function.Name, config.Name.ValueString()) {
+				ids = append(ids, function.ID)
+			}
+		}
+		id = singleNamedID("function", config.Name.ValueString(), ids, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	function, err := d.client.GetFunction(ctx, id)
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Function not found", fmt.Sprintf("No Open WebUI function was found with id %q.", id))
+			return
+		}
+		resp.Diagnostics.AddError("Read function failed", err.Error())
+		return
+	}
+
+	valves, err := d.client.GetFunctionValvesSpec(ctx, id)
+	if err != nil && err != client.ErrNotFound {
+		resp.Diagnostics.AddError("Read function valves failed", err.Error())
+		return
+	}
+
+	state := functionDataSourceModel{
+		ID:             types.StringValue(function.ID),
+		Name:           types.StringValue(function.Name),
+		Type:           types.StringValue(function.Type),
+		IsActive:       types.BoolValue(function.IsActive),
+		IsGlobal:       types.BoolValue(function.IsGlobal),
+		Description:    types.StringNull(),
+		Content:        types.StringValue(function.Content),
+		ManifestJSON:   normalizedJSONValue(function.Meta["manifest"], path.Root("manifest_json"), &resp.Diagnostics),
+		ValvesSpecJSON: normalizedJSONValue(valves, path.Root("valves_spec_json"), &resp.Diagnostics),
+		UserID:         types.StringValue(function.UserID),
+		CreatedAt:      formatDateValue(function.CreatedAt),
+		UpdatedAt:      formatDateValue(function.UpdatedAt),
+	}
+	if description, ok := toStringValue(function.Meta["description"]); ok {
+		state.Description = types.StringValue(description)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &functionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &functionsDataSource{}
+
+// functionsDataSource lists functions.
+type functionsDataSource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// functionsDataSourceModel maps the data source schema data.
+type functionsDataSourceModel struct {
+	listFilterModel
+	Type      types.String          `tfsdk:"type"`
+	IsActive  types.Bool            `tfsdk:"is_active"`
+	IsGlobal  types.Bool            `tfsdk:"is_global"`
+	IDs       types.List            `tfsdk:"ids"`
+	Functions []functionsEntryModel `tfsdk:"functions"`
+}
+
+// functionsEntryModel describes a listed function.
+type functionsEntryModel struct {
+	ID           types.String         `tfsdk:"id"`
+	Name         types.String         `tfsdk:"name"`
+	Type         types.String         `tfsdk:"type"`
+	IsActive     types.Bool           `tfsdk:"is_active"`
+	IsGlobal     types.Bool           `tfsdk:"is_global"`
+	Description  types.String         `tfsdk:"description"`
+	ManifestJSON jsontypes.Normalized `tfsdk:"manifest_json"`
+	UserID       types.String         `tfsdk:"user_id"`
+	CreatedAt    types.String         `tfsdk:"created_at"`
+	UpdatedAt    types.String         `tfsdk:"updated_at"`
+}
+
+// NewFunctionsDataSource constructs a new functions data source.
+func NewFunctionsDataSource() datasource.DataSource {
+	return &functionsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *functionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_functions"
+}
+
+// Schema describes the functions data source schema.
+func (d *functionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(listFilterAttributes("the function name or ID"), map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return functions of this type: `filter`, `action` or `pipe`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("filter", "action", "pipe"),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return enabled (`true`) or disabled (`false`) functions.",
+			},
+			"is_global": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return global (`true`) or model-scoped (`false`) functions.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Identifiers of the matching functions, sorted.",
+			},
+			"functions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching functions, sorted by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Function identifier.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Function type: `filter`, `action` or `pipe`.",
+						},
+						"is_active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the function is enabled.",
+						},
+						"is_global": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the filter or action applies to every model instead of only the models that select it.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description from the function metadata.",
+						},
+						"manifest_json": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "Manifest parsed from the function's frontmatter, as JSON.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who owns the function.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date formatted as YYYY-MM-DD.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last update date formatted as YYYY-MM-DD.",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *functionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+	}
+}
+
+// Read lists the functions and applies the filters.
+func (d *functionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the functions data source.")
+		return
+	}
+
+	var config functionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newListFilter(ctx, d.client, d.userMatch, config.listFilterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	functions, err := d.client.ListFunctions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List functions failed", err.Error())
+		return
+	}
+
+	sort.Slice(functions, func(i, j int) bool { return functions[i].ID < functions[j].ID })
+
+	ids := []string{}
+	config.Functions = []functionsEntryModel{}
+	for _, function := range functions {
+		if !filter.matches(function.UserID, nil, function.Name, function.ID) {
+			continue
+		}
+		if value := config.Type.ValueString(); value != "" && function.Type != value {
+			continue
+		}
+		if !config.IsActive.IsNull() && config.IsActive.ValueBool() != function.IsActive {
+			continue
+		}
+		if !config.IsGlobal.IsNull() && config.IsGlobal.ValueBool() != function.IsGlobal {
+			continue
+		}
+
+		entry := functionsEntryModel{
+			ID:           types.StringValue(function.ID),
+			Name:         types.StringValue(function.Name),
+			Type:         types.StringValue(function.Type),
+			IsActive:     types.BoolValue(function.IsActive),
+			IsGlobal:     types.BoolValue(function.IsGlobal),
+			Description:  types.StringNull(),
+			ManifestJSON: normalizedJSONValue(function.Meta["manifest"], path.Root("functions").AtListIndex(len(config.Functions)).AtName("manifest_json"), &resp.Diagnostics),
+			UserID:       types.StringValue(function.UserID),
+			CreatedAt:    formatDateValue(function.CreatedAt),
+			UpdatedAt:    formatDateValue(function.UpdatedAt),
+		}
+		if description, ok := toStringValue(function.Meta["description"]); ok {
+			entry.Description = types.StringValue(description)
+		}
+
+		ids = append(ids, function.ID)
+		config.Functions = append(config.Functions, entry)
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idList
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
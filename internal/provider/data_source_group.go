@@ -24,7 +24,8 @@ type groupDataSource struct {
 // groupDataSourceModel embeds the resource representation and adds the lookup identifier.
 type groupDataSourceModel struct {
 	GroupID types.String `tfsdk:"group_id"`
-	groupResourceModel
+	groupEntryModel
+	Permissions groupPermissionsModel `tfsdk:"permissions"`
 }
 
 // NewGroupDataSource constructs a new group data source.
@@ -59,11 +60,16 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "Group description.",
 			},
-			"users": schema.ListAttribute{
+			"users": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "User identifiers (or usernames/emails) that belong to the group.",
 			},
+			"admins": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Usernames or email addresses of the group administrators.",
+			},
 			"permissions": schema.SingleNestedAttribute{
 				Computed:    true,
 				Description: "Fine-grained permission flags organised by category.",
@@ -84,6 +90,11 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 						ElementType: types.BoolType,
 						Computed:    true,
 					},
+					"additional": schema.MapAttribute{
+						ElementType: groupPermissionFlagsType,
+						Computed:    true,
+						Description: "Permission categories without a dedicated attribute, keyed by category name.",
+					},
 				},
 			},
 			"meta_json": schema.StringAttribute{
@@ -116,8 +127,8 @@ func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		d.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
 	}
 }
 
@@ -203,13 +214,16 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	model, diags := groupResponseToModel(ctx, d.client, current)
 	resp.Diagnostics.Append(diags...)
+	permissions, permDiags := flattenPermissions(ctx, current.Permissions)
+	resp.Diagnostics.Append(permDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := groupDataSourceModel{
-		GroupID:            types.StringValue(current.ID),
-		groupResourceModel: model,
+		GroupID:         types.StringValue(current.ID),
+		groupEntryModel: model,
+		Permissions:     permissions,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
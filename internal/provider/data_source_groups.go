@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &groupsDataSource{}
+var _ datasource.DataSourceWithConfigure = &groupsDataSource{}
+
+// groupsDataSource lists groups.
+type groupsDataSource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// groupsDataSourceModel maps the data source schema data.
+type groupsDataSourceModel struct {
+	listFilterModel
+	Groups []groupsEntryModel `tfsdk:"groups"`
+}
+
+// groupsEntryModel describes a listed group.
+type groupsEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	UserIDs     types.Set    `tfsdk:"user_ids"`
+	AdminIDs    types.Set    `tfsdk:"admin_ids"`
+	UserID      types.String `tfsdk:"user_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewGroupsDataSource constructs a new groups data source.
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+// Schema describes the groups data source schema.
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(listFilterAttributes("the group name"), map[string]schema.Attribute{
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching groups, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group identifier.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group name.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group description.",
+						},
+						"user_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Identifiers of the group members.",
+						},
+						"admin_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Identifiers of the group administrators.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who owns the group.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date formatted as YYYY-MM-DD.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last update date formatted as YYYY-MM-DD.",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+	}
+}
+
+// Read lists the groups and applies the filters.
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the groups data source.")
+		return
+	}
+
+	var config groupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newListFilter(ctx, d.client, d.userMatch, config.listFilterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List groups failed", err.Error())
+		return
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+
+	config.Groups = []groupsEntryModel{}
+	for _, group := range groups {
+		if !filter.matches(group.UserID, nil, group.Name) {
+			continue
+		}
+
+		userIDs, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(uniqueStrings(group.UserIDs)))
+		resp.Diagnostics.Append(diags...)
+		adminIDs, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(uniqueStrings(group.AdminIDs)))
+		resp.Diagnostics.Append(diags...)
+
+		config.Groups = append(config.Groups, groupsEntryModel{
+			ID:          types.StringValue(group.ID),
+			Name:        types.StringValue(group.Name),
+			Description: types.StringValue(group.Description),
+			UserIDs:     userIDs,
+			AdminIDs:    adminIDs,
+			UserID:      types.StringValue(group.UserID),
+			CreatedAt:   formatDateValue(group.CreatedAt),
+			UpdatedAt:   formatDateValue(group.UpdatedAt),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
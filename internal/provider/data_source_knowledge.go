@@ -24,7 +24,7 @@ type knowledgeDataSource struct {
 // knowledgeDataSourceModel embeds the resource fields and adds the lookup identifier.
 type knowledgeDataSourceModel struct {
 	KnowledgeID types.String `tfsdk:"knowledge_id"`
-	knowledgeResourceModel
+	knowledgeEntryModel
 }
 
 // NewKnowledgeDataSource creates a new knowledge data source instance.
@@ -67,16 +67,40 @@ func (d *knowledgeDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Computed:    true,
 				Description: "JSON payload describing metadata for the knowledge entry.",
 			},
-			"read_groups": schema.ListAttribute{
+			"read_groups": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "Group names granted read access.",
 			},
-			"write_groups": schema.ListAttribute{
+			"write_groups": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "Group names granted write access.",
 			},
+			"read_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Group IDs granted read access.",
+			},
+			"write_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Group IDs granted write access.",
+			},
+			"read_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Email addresses of users granted read access.",
+			},
+			"write_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Email addresses of users granted write access.",
+			},
+			"visibility": schema.StringAttribute{
+				Computed:    true,
+				Description: "Who can access the record: `public`, `private` or `restricted`.",
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Creation date in YYYY-MM-DD format.",
@@ -89,6 +113,10 @@ func (d *knowledgeDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Computed:    true,
 				Description: "Identifier of the user who owns the knowledge entry.",
 			},
+			"file_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of files attached to the knowledge entry.",
+			},
 		},
 	}
 }
@@ -99,8 +127,8 @@ func (d *knowledgeDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		d.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
 	}
 }
 
@@ -184,15 +212,16 @@ func (d *knowledgeDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	model, diags := knowledgeResponseToModel(ctx, d.client, *current)
+	model, diags := knowledgeResponseToModel(ctx, d.client, *current, accessControlModel{})
+	model.exposeAccessControl(ctx, d.client, current.AccessControl, &resp.Diagnostics)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := knowledgeDataSourceModel{
-		KnowledgeID:            types.StringValue(current.ID),
-		knowledgeResourceModel: model,
+		KnowledgeID:         types.StringValue(current.ID),
+		knowledgeEntryModel: model,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &knowledgeBasesDataSource{}
+var _ datasource.DataSourceWithConfigure = &knowledgeBasesDataSource{}
+
+// knowledgeBasesDataSource lists knowledge bases.
+type knowledgeBasesDataSource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// knowledgeBasesDataSourceModel maps the data source schema data.
+type knowledgeBasesDataSourceModel struct {
+	accessFilterModel
+	KnowledgeBases []knowledgeBasesEntryModel `tfsdk:"knowledge_bases"`
+}
+
+// knowledgeBasesEntryModel describes a listed knowledge base.
+type knowledgeBasesEntryModel struct {
+	listAccessModel
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	UserID      types.String `tfsdk:"user_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewKnowledgeBasesDataSource constructs a new knowledge bases data source.
+func NewKnowledgeBasesDataSource() datasource.DataSource {
+	return &knowledgeBasesDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *knowledgeBasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_bases"
+}
+
+// Schema describes the knowledge bases data source schema.
+func (d *knowledgeBasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(accessFilterAttributes("the knowledge base name"), map[string]schema.Attribute{
+			"knowledge_bases": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching knowledge bases, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mergeAttributes(listAccessAttributes(), map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Knowledge base identifier.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Knowledge base name.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Knowledge base description.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who owns the knowledge base.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date formatted as YYYY-MM-DD.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last update date formatted as YYYY-MM-DD.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *knowledgeBasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+	}
+}
+
+// Read lists the knowledge bases and applies the filters.
+func (d *knowledgeBasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the knowledge bases data source.")
+		return
+	}
+
+	var config knowledgeBasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newAccessFilter(ctx, d.client, d.userMatch, config.accessFilterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := d.client.ListKnowledge(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List knowledge failed", err.Error())
+		return
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	config.KnowledgeBases = []knowledgeBasesEntryModel{}
+	for _, entry := range entries {
+		if !filter.matches(entry.UserID, entry.AccessControl, entry.Name) {
+			continue
+		}
+
+		config.KnowledgeBases = append(config.KnowledgeBases, knowledgeBasesEntryModel{
+			listAccessModel: flattenListAccess(ctx, entry.AccessControl, &resp.Diagnostics),
+			ID:              types.StringValue(entry.ID),
+			Name:            types.StringValue(entry.Name),
+			Description:     types.StringValue(entry.Description),
+			UserID:          types.StringValue(entry.UserID),
+			CreatedAt:       formatDateValue(entry.CreatedAt),
+			UpdatedAt:       formatDateValue(entry.UpdatedAt),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &memoryQueryDataSource{}
+var _ datasource.DataSourceWithConfigure = &memoryQueryDataSource{}
+
+// memoryQueryDataSource runs a similarity search over the memories of the provider's identity.
+type memoryQueryDataSource struct {
+	client *client.Client
+}
+
+// memoryQueryDataSourceModel maps the data source schema data.
+type memoryQueryDataSourceModel struct {
+	Query   types.String             `tfsdk:"query"`
+	K       types.Int64              `tfsdk:"k"`
+	Results []memoryQueryResultModel `tfsdk:"results"`
+}
+
+// memoryQueryResultModel describes a single retrieved memory.
+type memoryQueryResultModel struct {
+	ID       types.String  `tfsdk:"id"`
+	Content  types.String  `tfsdk:"content"`
+	Distance types.Float64 `tfsdk:"distance"`
+}
+
+// NewMemoryQueryDataSource constructs a new memory query data source.
+func NewMemoryQueryDataSource() datasource.DataSource {
+	return &memoryQueryDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *memoryQueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_memory_query"
+}
+
+// Schema describes the memory query data source schema.
+func (d *memoryQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Required:    true,
+				Description: "Text to search the memories for.",
+			},
+			"k": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of memories to return. Defaults to 1.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Retrieved memories, most similar first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Memory identifier.",
+						},
+						"content": schema.StringAttribute{
+							Computed:    true,
+							Description: "Text of the memory.",
+						},
+						"distance": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Distance reported by the vector database; lower values are more similar for most backends.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *memoryQueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read runs the memory query.
+func (d *memoryQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the memory query data source.")
+		return
+	}
+
+	var config memoryQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	k := int64(1)
+	if !config.K.IsNull() && !config.K.IsUnknown() {
+		k = config.K.ValueInt64()
+	}
+
+	result, err := d.client.QueryMemories(ctx, client.MemoryQueryForm{
+		Content: config.Query.ValueString(),
+		K:       k,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Query memories failed", err.Error())
+		return
+	}
+
+	config.Results = memoryQueryResultsToModel(*result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// memoryQueryResultsToModel flattens the single-query vector search result.
+func memoryQueryResultsToModel(result client.MemoryQueryResult) []memoryQueryResultModel {
+	results := []memoryQueryResultModel{}
+	if len(result.IDs) == 0 {
+		return results
+	}
+
+	for i, id := range result.IDs[0] {
+		entry := memoryQueryResultModel{
+			ID:       types.StringValue(id),
+			Content:  types.StringNull(),
+			Distance: types.Float64Null(),
+		}
+		if len(result.Documents) > 0 && i < len(result.Documents[0]) {
+			entry.Content = types.StringValue(result.Documents[0][i])
+		}
+		if len(result.Distances) > 0 && i < len(result.Distances[0]) {
+			entry.Distance = types.Float64Value(result.Distances[0][i])
+		}
+		results = append(results, entry)
+	}
+
+	return results
+}
@@ -76,16 +76,40 @@ func (d *modelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "Raw JSON fragment preserving parameter fields not modelled directly.",
 			},
-			"read_groups": schema.ListAttribute{
+			"read_groups": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "Group names granted read access to the model.",
 			},
-			"write_groups": schema.ListAttribute{
+			"write_groups": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "Group names granted write access to the model.",
 			},
+			"read_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Group IDs granted read access to the model.",
+			},
+			"write_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Group IDs granted write access to the model.",
+			},
+			"read_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Email addresses of users granted read access.",
+			},
+			"write_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Email addresses of users granted write access.",
+			},
+			"visibility": schema.StringAttribute{
+				Computed:    true,
+				Description: "Who can access the record: `public`, `private` or `restricted`.",
+			},
 			"params": schema.SingleNestedAttribute{
 				Computed:    true,
 				Description: "Parameter values returned by Open WebUI.",
@@ -154,8 +178,8 @@ func (d *modelDataSource) Configure(_ context.Context, req datasource.ConfigureR
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		d.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
 	}
 }
 
@@ -196,7 +220,8 @@ func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	state, diags := modelResponseToModel(ctx, d.client, current, config.ModelID.ValueString())
+	state, diags := modelResponseToModel(ctx, d.client, current, config.ModelID.ValueString(), accessControlModel{})
+	state.exposeAccessControl(ctx, d.client, current.AccessControl, &resp.Diagnostics)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &modelsDataSource{}
+var _ datasource.DataSourceWithConfigure = &modelsDataSource{}
+
+// modelsDataSource lists workspace models.
+type modelsDataSource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// modelsDataSourceModel maps the data source schema data.
+type modelsDataSourceModel struct {
+	accessFilterModel
+	Tag      types.String       `tfsdk:"tag"`
+	IsActive types.Bool         `tfsdk:"is_active"`
+	Models   []modelsEntryModel `tfsdk:"models"`
+}
+
+// modelsEntryModel describes a listed model.
+type modelsEntryModel struct {
+	listAccessModel
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	BaseModelID types.String `tfsdk:"base_model_id"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Set    `tfsdk:"tags"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	UserID      types.String `tfsdk:"user_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// NewModelsDataSource constructs a new models data source.
+func NewModelsDataSource() datasource.DataSource {
+	return &modelsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *modelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_models"
+}
+
+// Schema describes the models data source schema.
+func (d *modelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(accessFilterAttributes("the model name or ID"), map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return models with this tag (case-insensitive).",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return active (`true`) or inactive (`false`) models.",
+			},
+			"models": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching models, sorted by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mergeAttributes(listAccessAttributes(), map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Model identifier.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name.",
+						},
+						"base_model_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the model this one is built on.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description from the model metadata.",
+						},
+						"tags": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags associated with the model.",
+						},
+						"is_active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the model is active.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who owns the model.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date formatted as YYYY-MM-DD.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last update date formatted as YYYY-MM-DD.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *modelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+	}
+}
+
+// Read lists the models and applies the filters.
+func (d *modelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the models data source.")
+		return
+	}
+
+	var config modelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newAccessFilter(ctx, d.client, d.userMatch, config.accessFilterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	models, err := d.client.ListModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List models failed", err.Error())
+		return
+	}
+
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+
+	config.Models = []modelsEntryModel{}
+	for _, model := range models {
+		if !filter.matches(model.UserID, model.AccessControl, model.Name, model.ID) {
+			continue
+		}
+		if !config.IsActive.IsNull() && config.IsActive.ValueBool() != model.IsActive {
+			continue
+		}
+
+		tags, _ := toKeyedStringSlice(model.Meta["tags"], "name")
+		if tag := config.Tag.ValueString(); tag != "" && !containsFold(tags, tag) {
+			continue
+		}
+
+		config.Models = append(config.Models, modelsEntry(ctx, model, tags, &resp.Diagnostics))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// modelsEntry converts a listed model.
+func modelsEntry(ctx context.Context, model client.ModelResponse, tags []string, diags *diag.Diagnostics) modelsEntryModel {
+	tagSet, tagDiags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(uniqueStrings(tags)))
+	diags.Append(tagDiags...)
+
+	entry := modelsEntryModel{
+		listAccessModel: flattenListAccess(ctx, model.AccessControl, diags),
+		ID:              types.StringValue(model.ID),
+		Name:            types.StringValue(model.Name),
+		BaseModelID:     types.StringPointerValue(model.BaseModelID),
+		Description:     types.StringNull(),
+		Tags:            tagSet,
+		IsActive:        types.BoolValue(model.IsActive),
+		UserID:          types.StringValue(model.UserID),
+		CreatedAt:       formatDateValue(model.CreatedAt),
+		UpdatedAt:       formatDateValue(model.UpdatedAt),
+	}
+	if description, ok := toStringValue(model.Meta["description"]); ok {
+		entry.Description = types.StringValue(description)
+	}
+
+	return entry
+}
+
+// containsFold reports whether values contains value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
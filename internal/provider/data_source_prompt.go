@@ -52,16 +52,40 @@ func (d *promptDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "Prompt content text.",
 			},
-			"read_groups": schema.ListAttribute{
+			"read_groups": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "Group names granted read access.",
 			},
-			"write_groups": schema.ListAttribute{
+			"write_groups": schema.SetAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 				Description: "Group names granted write access.",
 			},
+			"read_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Group IDs granted read access.",
+			},
+			"write_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Group IDs granted write access.",
+			},
+			"read_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Email addresses of users granted read access.",
+			},
+			"write_users": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Email addresses of users granted write access.",
+			},
+			"visibility": schema.StringAttribute{
+				Computed:    true,
+				Description: "Who can access the record: `public`, `private` or `restricted`.",
+			},
 			"timestamp": schema.StringAttribute{
 				Computed:    true,
 				Description: "Prompt timestamp formatted as YYYY-MM-DD.",
@@ -80,8 +104,8 @@ func (d *promptDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		d.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
 	}
 }
 
@@ -122,7 +146,8 @@ func (d *promptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	state, diags := promptResponseToModel(ctx, d.client, current)
+	state, diags := promptResponseToModel(ctx, d.client, current, accessControlModel{})
+	state.exposeAccessControl(ctx, d.client, current.AccessControl, &resp.Diagnostics)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &promptsDataSource{}
+var _ datasource.DataSourceWithConfigure = &promptsDataSource{}
+
+// promptsDataSource lists prompts.
+type promptsDataSource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// promptsDataSourceModel maps the data source schema data.
+type promptsDataSourceModel struct {
+	accessFilterModel
+	Prompts []promptsEntryModel `tfsdk:"prompts"`
+}
+
+// promptsEntryModel describes a listed prompt.
+type promptsEntryModel struct {
+	listAccessModel
+	Command   types.String `tfsdk:"command"`
+	Title     types.String `tfsdk:"title"`
+	Content   types.String `tfsdk:"content"`
+	UserID    types.String `tfsdk:"user_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// NewPromptsDataSource constructs a new prompts data source.
+func NewPromptsDataSource() datasource.DataSource {
+	return &promptsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *promptsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompts"
+}
+
+// Schema describes the prompts data source schema.
+func (d *promptsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(accessFilterAttributes("the prompt title or command"), map[string]schema.Attribute{
+			"prompts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching prompts, sorted by command.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mergeAttributes(listAccessAttributes(), map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Computed:    true,
+							Description: "Slash command of the prompt, such as `/summarize`.",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Prompt title.",
+						},
+						"content": schema.StringAttribute{
+							Computed:    true,
+							Description: "Prompt content.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who owns the prompt.",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:    true,
+							Description: "Prompt timestamp formatted as YYYY-MM-DD.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *promptsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+	}
+}
+
+// Read lists the prompts and applies the filters.
+func (d *promptsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the prompts data source.")
+		return
+	}
+
+	var config promptsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newAccessFilter(ctx, d.client, d.userMatch, config.accessFilterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prompts, err := d.client.ListPrompts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List prompts failed", err.Error())
+		return
+	}
+
+	sort.Slice(prompts, func(i, j int) bool { return prompts[i].Command < prompts[j].Command })
+
+	config.Prompts = []promptsEntryModel{}
+	for _, prompt := range prompts {
+		command := normalizePromptCommand(prompt.Command)
+		if !filter.matches(prompt.UserID, prompt.AccessControl, prompt.Title, command) {
+			continue
+		}
+
+		config.Prompts = append(config.Prompts, promptsEntryModel{
+			listAccessModel: flattenListAccess(ctx, prompt.AccessControl, &resp.Diagnostics),
+			Command:         types.StringValue(command),
+			Title:           types.StringValue(prompt.Title),
+			Content:         types.StringValue(prompt.Content),
+			UserID:          types.StringValue(prompt.UserID),
+			Timestamp:       formatDateValue(prompt.Timestamp),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
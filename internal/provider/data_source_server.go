@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &serverDataSource{}
+var _ datasource.DataSourceWithConfigure = &serverDataSource{}
+
+// serverAuthMethods maps sign-in methods to the feature flag that enables them.
+var serverAuthMethods = map[string]string{
+	"password":       "enable_login_form",
+	"ldap":           "enable_ldap",
+	"trusted_header": "auth_trusted_header",
+	"api_key":        "enable_api_key",
+}
+
+// serverDataSource exposes the version and configuration of the Open WebUI server.
+type serverDataSource struct {
+	client *client.Client
+}
+
+// serverDataSourceModel maps the data source schema data.
+type serverDataSourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	Version        types.String `tfsdk:"version"`
+	DefaultLocale  types.String `tfsdk:"default_locale"`
+	Features       types.Map    `tfsdk:"features"`
+	AuthMethods    types.Set    `tfsdk:"auth_methods"`
+	OAuthProviders types.Set    `tfsdk:"oauth_providers"`
+}
+
+// NewServerDataSource constructs a new server data source.
+func NewServerDataSource() datasource.DataSource {
+	return &serverDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *serverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+// Schema describes the server data source schema.
+func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name the instance is branded with.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Open WebUI version.",
+			},
+			"default_locale": schema.StringAttribute{
+				Computed:    true,
+				Description: "Default interface locale.",
+			},
+			"features": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.BoolType,
+				Description: "Feature flags reported by the server, such as `enable_signup` or `enable_web_search`.",
+			},
+			"auth_methods": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Enabled sign-in methods: `password`, `ldap`, `trusted_header`, `api_key` and `oauth`.",
+			},
+			"oauth_providers": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Configured OAuth providers, such as `google` or `oidc`.",
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *serverDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read fetches the server configuration.
+func (d *serverDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the server data source.")
+		return
+	}
+
+	config, err := d.client.GetServerConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read server configuration failed", err.Error())
+		return
+	}
+
+	features := make(map[string]bool, len(config.Features))
+	for key, raw := range config.Features {
+		if enabled, ok := raw.(bool); ok {
+			features[key] = enabled
+		}
+	}
+
+	methods := []string{}
+	for _, method := range sortedKeys(serverAuthMethods) {
+		if features[serverAuthMethods[method]] {
+			methods = append(methods, method)
+		}
+	}
+
+	providers := sortedKeys(config.OAuth.Providers)
+	if len(providers) > 0 {
+		methods = append(methods, "oauth")
+	}
+
+	state := serverDataSourceModel{
+		Name:          types.StringValue(config.Name),
+		Version:       types.StringValue(config.Version),
+		DefaultLocale: types.StringValue(config.DefaultLocale),
+	}
+
+	featureMap, diags := types.MapValueFrom(ctx, types.BoolType, features)
+	resp.Diagnostics.Append(diags...)
+	state.Features = featureMap
+
+	methodSet, diags := types.SetValueFrom(ctx, types.StringType, methods)
+	resp.Diagnostics.Append(diags...)
+	state.AuthMethods = methodSet
+
+	providerSet, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(providers))
+	resp.Diagnostics.Append(diags...)
+	state.OAuthProviders = providerSet
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
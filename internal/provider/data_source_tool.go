@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &toolDataSource{}
+var _ datasource.DataSourceWithConfigure = &toolDataSource{}
+
+// toolDataSource looks up a single tool.
+type toolDataSource struct {
+	client *client.Client
+}
+
+// toolDataSourceModel maps the data source schema data.
+type toolDataSourceModel struct {
+	listAccessModel
+	ID             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	Description    types.String         `tfsdk:"description"`
+	Content        types.String         `tfsdk:"content"`
+	ManifestJSON   jsontypes.Normalized `tfsdk:"manifest_json"`
+	SpecsJSON      jsontypes.Normalized `tfsdk:"specs_json"`
+	ValvesSpecJSON jsontypes.Normalized `tfsdk:"valves_spec_json"`
+	UserID         types.String         `tfsdk:"user_id"`
+	CreatedAt      types.String         `tfsdk:"created_at"`
+	UpdatedAt      types.String         `tfsdk:"updated_at"`
+}
+
+// NewToolDataSource constructs a new tool data source.
+func NewToolDataSource() datasource.DataSource {
+	return &toolDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *toolDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool"
+}
+
+// Schema describes the tool data source schema.
+func (d *toolDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(listAccessAttributes(), map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of the tool to retrieve. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the tool to retrieve, compared case-insensitively.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description from the tool metadata.",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "Python source of the tool.",
+			},
+			"manifest_json": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Manifest parsed from the tool's frontmatter, such as its author and version, as JSON.",
+			},
+			"specs_json": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON array of the function specs the tool exposes to models.",
+			},
+			"valves_spec_json": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON schema of the tool's valves, or null when it declares none.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the user who owns the tool.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date formatted as YYYY-MM-DD.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date formatted as YYYY-MM-DD.",
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *toolDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read looks up the tool and its valves schema.
+func (d *toolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the tool data source.")
+		return
+	}
+
+	var config toolDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	if id == "" {
+		tools, err := d.client.ListTools(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("List tools failed", err.Error())
+			return
+		}
+
+		var ids []string
+		for _, tool := range tools {
+			if strings.EqualFold(tool.Name, config.Name.ValueString()) {
+				ids = append(ids, tool.ID)
+			}
+		}
+		id = singleNamedID("tool", config.Name.ValueString(), ids, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tool, err := d.client.GetTool(ctx, id)
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Tool not found", fmt.Sprintf("No Open WebUI tool was found with id %q.", id))
+			return
+		}
+		resp.Diagnostics.AddError("Read tool failed", err.Error())
+		return
+	}
+
+	valves, err := d.client.GetToolValvesSpec(ctx, id)
+	if err != nil && err != client.ErrNotFound {
+		resp.Diagnostics.AddError("Read tool valves failed", err.Error())
+		return
+	}
+
+	state := toolDataSourceModel{
+		listAccessModel: flattenListAccess(ctx, tool.AccessControl, &resp.Diagnostics),
+		ID:              types.StringValue(tool.ID),
+		Name:            types.StringValue(tool.Name),
+		Description:     types.StringNull(),
+		Content:         types.StringValue(tool.Content),
+		ManifestJSON:    normalizedJSONValue(tool.Meta["manifest"], path.Root("manifest_json"), &resp.Diagnostics),
+		SpecsJSON:       normalizedJSONValue(tool.Specs, path.Root("specs_json"), &resp.Diagnostics),
+		ValvesSpecJSON:  normalizedJSONValue(valves, path.Root("valves_spec_json"), &resp.Diagnostics),
+		UserID:          types.StringValue(tool.UserID),
+		CreatedAt:       formatDateValue(tool.CreatedAt),
+		UpdatedAt:       formatDateValue(tool.UpdatedAt),
+	}
+	if description, ok := toStringValue(tool.Meta["description"]); ok {
+		state.Description = types.StringValue(description)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// singleNamedID returns the only ID in ids, reporting a missing or ambiguous name on the
+// name attribute otherwise.
+func singleNamedID(kind, name string, ids []string, diags *diag.Diagnostics) string {
+	switch len(ids) {
+	case 1:
+		return ids[0]
+	case 0:
+		diags.AddAttributeError(path.Root("name"), fmt.Sprintf("Unknown %s name", kind), fmt.Sprintf("No Open WebUI %s is named %q.", kind, name))
+	default:
+		diags.AddAttributeError(path.Root("name"), fmt.Sprintf("Ambiguous %s name", kind), fmt.Sprintf("%d %ss are named %q (%s); look it up by id instead.", len(ids), kind, name, strings.Join(ids, ", ")))
+	}
+
+	return ""
+}
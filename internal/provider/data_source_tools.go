@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &toolsDataSource{}
+var _ datasource.DataSourceWithConfigure = &toolsDataSource{}
+
+// toolsDataSource lists tools.
+type toolsDataSource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// toolsDataSourceModel maps the data source schema data.
+type toolsDataSourceModel struct {
+	accessFilterModel
+	IDs   types.List        `tfsdk:"ids"`
+	Tools []toolsEntryModel `tfsdk:"tools"`
+}
+
+// toolsEntryModel describes a listed tool.
+type toolsEntryModel struct {
+	listAccessModel
+	ID           types.String         `tfsdk:"id"`
+	Name         types.String         `tfsdk:"name"`
+	Description  types.String         `tfsdk:"description"`
+	ManifestJSON jsontypes.Normalized `tfsdk:"manifest_json"`
+	UserID       types.String         `tfsdk:"user_id"`
+	CreatedAt    types.String         `tfsdk:"created_at"`
+	UpdatedAt    types.String         `tfsdk:"updated_at"`
+}
+
+// NewToolsDataSource constructs a new tools data source.
+func NewToolsDataSource() datasource.DataSource {
+	return &toolsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *toolsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tools"
+}
+
+// Schema describes the tools data source schema.
+func (d *toolsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: mergeAttributes(accessFilterAttributes("the tool name or ID"), map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Identifiers of the matching tools, for use in `tool_ids` of `openwebui_model`.",
+			},
+			"tools": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching tools, sorted by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mergeAttributes(listAccessAttributes(), map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Tool identifier.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description from the tool metadata.",
+						},
+						"manifest_json": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "Manifest parsed from the tool's frontmatter, as JSON.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the user who owns the tool.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation date formatted as YYYY-MM-DD.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last update date formatted as YYYY-MM-DD.",
+						},
+					}),
+				},
+			},
+		}),
+	}
+}
+
+// Configure attaches the API client.
+func (d *toolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+	}
+}
+
+// Read lists the tools and applies the filters.
+func (d *toolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the tools data source.")
+		return
+	}
+
+	var config toolsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newAccessFilter(ctx, d.client, d.userMatch, config.accessFilterModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tools, err := d.client.ListTools(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List tools failed", err.Error())
+		return
+	}
+
+	sort.Slice(tools, func(i, j int) bool { return tools[i].ID < tools[j].ID })
+
+	ids := []string{}
+	config.Tools = []toolsEntryModel{}
+	for _, tool := range tools {
+		if !filter.matches(tool.UserID, tool.AccessControl, tool.Name, tool.ID) {
+			continue
+		}
+
+		entry := toolsEntryModel{
+			listAccessModel: flattenListAccess(ctx, tool.AccessControl, &resp.Diagnostics),
+			ID:              types.StringValue(tool.ID),
+			Name:            types.StringValue(tool.Name),
+			Description:     types.StringNull(),
+			ManifestJSON:    normalizedJSONValue(tool.Meta["manifest"], path.Root("tools").AtListIndex(len(config.Tools)).AtName("manifest_json"), &resp.Diagnostics),
+			UserID:          types.StringValue(tool.UserID),
+			CreatedAt:       formatDateValue(tool.CreatedAt),
+			UpdatedAt:       formatDateValue(tool.UpdatedAt),
+		}
+		if description, ok := toStringValue(tool.Meta["description"]); ok {
+			entry.Description = types.StringValue(description)
+		}
+
+		ids = append(ids, tool.ID)
+		config.Tools = append(config.Tools, entry)
+	}
+
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	config.IDs = idList
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
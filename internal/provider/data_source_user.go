@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &userDataSource{}
+var _ datasource.DataSourceWithConfigure = &userDataSource{}
+
+// userDataSource looks up a single user.
+type userDataSource struct {
+	client *client.Client
+}
+
+// userEntryModel holds the user attributes shared by the user and users data sources.
+type userEntryModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Email        types.String `tfsdk:"email"`
+	Username     types.String `tfsdk:"username"`
+	Role         types.String `tfsdk:"role"`
+	OAuthSubject types.String `tfsdk:"oauth_sub"`
+	LastActiveAt types.String `tfsdk:"last_active_at"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	GroupIDs     types.Set    `tfsdk:"group_ids"`
+	GroupNames   types.Set    `tfsdk:"group_names"`
+}
+
+// userEntryAttributes returns the schema of userEntryModel.
+func userEntryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "User identifier.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Display name.",
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "Email address.",
+		},
+		"username": schema.StringAttribute{
+			Computed:    true,
+			Description: "Username, if one is set.",
+		},
+		"role": schema.StringAttribute{
+			Computed:    true,
+			Description: "Role: `admin`, `user` or `pending`.",
+		},
+		"oauth_sub": schema.StringAttribute{
+			Computed:    true,
+			Description: "Subject of the OAuth identity the user signs in with, if any.",
+		},
+		"last_active_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Time of the user's last activity in RFC 3339 format.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "Creation date formatted as YYYY-MM-DD.",
+		},
+		"group_ids": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Identifiers of the groups the user belongs to.",
+		},
+		"group_names": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Names of the groups the user belongs to.",
+		},
+	}
+}
+
+// flattenUserEntry converts a user and the groups they belong to.
+func flattenUserEntry(ctx context.Context, user client.User, groups []client.GroupResponse, diags *diag.Diagnostics) userEntryModel {
+	groupIDs := []string{}
+	groupNames := []string{}
+	for _, group := range groups {
+		if slices.Contains(group.UserIDs, user.ID) {
+			groupIDs = append(groupIDs, group.ID)
+			groupNames = append(groupNames, group.Name)
+		}
+	}
+
+	idSet, idDiags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(groupIDs))
+	diags.Append(idDiags...)
+	nameSet, nameDiags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(uniqueStrings(groupNames)))
+	diags.Append(nameDiags...)
+
+	return userEntryModel{
+		ID:           types.StringValue(user.ID),
+		Name:         types.StringValue(user.Name),
+		Email:        types.StringValue(user.Email),
+		Username:     types.StringPointerValue(user.Username),
+		Role:         types.StringValue(user.Role),
+		OAuthSubject: types.StringPointerValue(user.OAuthSubject),
+		LastActiveAt: formatTimestampValue(user.LastActiveAt),
+		CreatedAt:    formatDateValue(user.CreatedAt),
+		GroupIDs:     idSet,
+		GroupNames:   nameSet,
+	}
+}
+
+// NewUserDataSource constructs a new user data source.
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema describes the user data source schema.
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	lookup := path.Expressions{
+		path.MatchRoot("id"),
+		path.MatchRoot("email"),
+		path.MatchRoot("username"),
+	}
+
+	attributes := userEntryAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Identifier of the user to retrieve. Exactly one of `id`, `email` or `username` must be set.",
+		Validators:  []validator.String{stringvalidator.ExactlyOneOf(lookup...)},
+	}
+	attributes["email"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Email address of the user to retrieve, compared case-insensitively.",
+	}
+	attributes["username"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Username of the user to retrieve, compared case-insensitively.",
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Configure attaches the API client.
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read looks up the user by exact ID, email address or username.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the user data source.")
+		return
+	}
+
+	var config userEntryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user client.User
+	switch {
+	case config.ID.ValueString() != "":
+		found, err := d.client.GetUser(ctx, config.ID.ValueString())
+		if err != nil {
+			if err == client.ErrNotFound {
+				resp.Diagnostics.AddAttributeError(path.Root("id"), "User not found", "No Open WebUI user was found with the supplied id.")
+				return
+			}
+			resp.Diagnostics.AddError("Read user failed", err.Error())
+			return
+		}
+		user = *found
+	case config.Email.ValueString() != "":
+		user = d.lookup(ctx, config.Email.ValueString(), path.Root("email"), func(u client.User) bool {
+			return strings.EqualFold(u.Email, config.Email.ValueString())
+		}, &resp.Diagnostics)
+	default:
+		user = d.lookup(ctx, config.Username.ValueString(), path.Root("username"), func(u client.User) bool {
+			return u.Username != nil && strings.EqualFold(*u.Username, config.Username.ValueString())
+		}, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List groups failed", err.Error())
+		return
+	}
+
+	state := flattenUserEntry(ctx, user, groups, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// lookup searches for identifier and returns the single user accepted by matches.
+func (d *userDataSource) lookup(ctx context.Context, identifier string, attribute path.Path, matches func(client.User) bool, diags *diag.Diagnostics) client.User {
+	users, err := d.client.SearchUsers(ctx, identifier, 50)
+	if err != nil {
+		diags.AddError("Search users failed", err.Error())
+		return client.User{}
+	}
+
+	var found []client.User
+	for _, user := range users {
+		if matches(user) {
+			found = append(found, user)
+		}
+	}
+
+	switch len(found) {
+	case 1:
+		return found[0]
+	case 0:
+		diags.AddAttributeError(attribute, "User not found", fmt.Sprintf("No Open WebUI user matches %q exactly.", identifier))
+	default:
+		diags.AddAttributeError(attribute, "Ambiguous user", fmt.Sprintf("%d users match %q: %s", len(found), identifier, describeUsers(found)))
+	}
+
+	return client.User{}
+}
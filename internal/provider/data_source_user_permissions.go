@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &userPermissionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &userPermissionsDataSource{}
+
+// userPermissionsDataSource computes the permissions a user receives from the default
+// permissions and their groups.
+type userPermissionsDataSource struct {
+	client      *client.Client
+	userMatch   string
+	permissions *permissionCatalog
+}
+
+// userPermissionsDataSourceModel maps the data source schema data.
+type userPermissionsDataSourceModel struct {
+	User         types.String               `tfsdk:"user"`
+	UserID       types.String               `tfsdk:"user_id"`
+	Email        types.String               `tfsdk:"email"`
+	Role         types.String               `tfsdk:"role"`
+	Unrestricted types.Bool                 `tfsdk:"unrestricted"`
+	GroupIDs     types.Set                  `tfsdk:"group_ids"`
+	Permissions  types.Map                  `tfsdk:"permissions"`
+	Grants       []userPermissionGrantModel `tfsdk:"grants"`
+}
+
+// userPermissionGrantModel attributes a granted permission to where it came from.
+type userPermissionGrantModel struct {
+	Category   types.String `tfsdk:"category"`
+	Key        types.String `tfsdk:"key"`
+	Default    types.Bool   `tfsdk:"default"`
+	GroupIDs   types.List   `tfsdk:"group_ids"`
+	GroupNames types.List   `tfsdk:"group_names"`
+}
+
+// NewUserPermissionsDataSource constructs a new user permissions data source.
+func NewUserPermissionsDataSource() datasource.DataSource {
+	return &userPermissionsDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *userPermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_permissions"
+}
+
+// Schema describes the user permissions data source schema.
+func (d *userPermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address, username or ID of the user, resolved with the provider's `user_match` mode.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the resolved user.",
+			},
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email address of the resolved user.",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role of the user.",
+			},
+			"unrestricted": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Open WebUI skips permission checks for the user, as it does for `admin` users. Every known permission is then reported as enabled.",
+			},
+			"group_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Identifiers of the groups the user belongs to.",
+			},
+			"permissions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: groupPermissionFlagsType,
+				Description: "Effective permission flags by category: the default permissions with every flag granted by one of the user's groups enabled, or every known flag for unrestricted users.",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Enabled permissions with their sources, sorted by category and key. For unrestricted users, keys with neither source are granted by the role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							Computed:    true,
+							Description: "Permission category, such as `chat`.",
+						},
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Permission key within the category.",
+						},
+						"default": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the default user permissions enable the key.",
+						},
+						"group_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Identifiers of the groups that enable the key.",
+						},
+						"group_names": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Names of the groups that enable the key, in the order of `group_ids`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *userPermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+		d.userMatch = data.userMatch
+		d.permissions = data.permissions
+	}
+}
+
+// Read resolves the user and merges the permissions of the defaults and their groups.
+func (d *userPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the user permissions data source.")
+		return
+	}
+
+	var config userPermissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := resolveUserIDs(ctx, d.client, []string{config.User.ValueString()}, d.userMatch, path.Root("user"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || len(ids) == 0 {
+		return
+	}
+
+	user, err := d.client.GetUser(ctx, ids[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Read user failed", err.Error())
+		return
+	}
+
+	defaults, err := d.client.GetDefaultPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read default permissions failed", err.Error())
+		return
+	}
+
+	groups, err := d.client.ListGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List groups failed", err.Error())
+		return
+	}
+
+	// Open WebUI starts from the default permissions and enables every flag that one of
+	// the user's groups enables; groups never disable a flag.
+	effective := permissionFlags(ctx, defaults)
+	sources := make(map[[2]string][]client.GroupResponse)
+	var groupIDs []string
+	for _, group := range groups {
+		if !slices.Contains(group.UserIDs, user.ID) {
+			continue
+		}
+		groupIDs = append(groupIDs, group.ID)
+
+		for category, bools := range permissionFlags(ctx, group.Permissions) {
+			for key, enabled := range bools {
+				if !enabled {
+					continue
+				}
+				if effective[category] == nil {
+					effective[category] = make(map[string]bool)
+				}
+				effective[category][key] = true
+				sources[[2]string{category, key}] = append(sources[[2]string{category, key}], group)
+			}
+		}
+	}
+
+	// Admins pass every permission check, so they hold every key Open WebUI knows of,
+	// whether or not the defaults or a group grant it.
+	unrestricted := user.Role == "admin"
+	if unrestricted && d.permissions != nil {
+		keys, err := d.permissions.load(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Read permission keys failed", err.Error())
+			return
+		}
+		for category, known := range keys {
+			if effective[category] == nil {
+				effective[category] = make(map[string]bool, len(known))
+			}
+			for key := range known {
+				effective[category][key] = true
+			}
+		}
+	}
+
+	defaultFlags := permissionFlags(ctx, defaults)
+
+	state := userPermissionsDataSourceModel{
+		User:         config.User,
+		UserID:       types.StringValue(user.ID),
+		Email:        types.StringValue(user.Email),
+		Role:         types.StringValue(user.Role),
+		Unrestricted: types.BoolValue(unrestricted),
+		Grants:       []userPermissionGrantModel{},
+	}
+
+	groupSet, diags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(groupIDs))
+	resp.Diagnostics.Append(diags...)
+	state.GroupIDs = groupSet
+
+	permissions, diags := types.MapValueFrom(ctx, groupPermissionFlagsType, effective)
+	resp.Diagnostics.Append(diags...)
+	state.Permissions = permissions
+
+	for _, category := range sortedKeys(effective) {
+		for _, key := range sortedKeys(effective[category]) {
+			if !effective[category][key] {
+				continue
+			}
+
+			grantIDs := []string{}
+			grantNames := []string{}
+			for _, group := range sources[[2]string{category, key}] {
+				grantIDs = append(grantIDs, group.ID)
+				grantNames = append(grantNames, group.Name)
+			}
+
+			idList, idDiags := types.ListValueFrom(ctx, types.StringType, grantIDs)
+			resp.Diagnostics.Append(idDiags...)
+			nameList, nameDiags := types.ListValueFrom(ctx, types.StringType, grantNames)
+			resp.Diagnostics.Append(nameDiags...)
+
+			state.Grants = append(state.Grants, userPermissionGrantModel{
+				Category:   types.StringValue(category),
+				Key:        types.StringValue(key),
+				Default:    types.BoolValue(defaultFlags[category][key]),
+				GroupIDs:   idList,
+				GroupNames: nameList,
+			})
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
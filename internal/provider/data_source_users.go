@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ datasource.DataSource = &usersDataSource{}
+var _ datasource.DataSourceWithConfigure = &usersDataSource{}
+
+// usersDataSource lists users.
+type usersDataSource struct {
+	client *client.Client
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	Query types.String     `tfsdk:"query"`
+	Role  types.String     `tfsdk:"role"`
+	Page  types.Int64      `tfsdk:"page"`
+	Total types.Int64      `tfsdk:"total"`
+	Users []userEntryModel `tfsdk:"users"`
+}
+
+// NewUsersDataSource constructs a new users data source.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// Metadata sets the data source identifier.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema describes the users data source schema.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Optional:    true,
+				Description: "Search term matched by the server against names, email addresses and usernames.",
+			},
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return users with this role: `admin`, `user` or `pending`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "user", "pending"),
+				},
+			},
+			"page": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Page of results to return, starting at 1. When omitted, every page is fetched.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of users matching `query` reported by the server, before the role filter.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching users in the order returned by the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userEntryAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure attaches the API client.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		d.client = data.client
+	}
+}
+
+// Read lists the users and applies the role filter.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before using the users data source.")
+		return
+	}
+
+	var config usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []client.User
+	var total int
+	if !config.Page.IsNull() {
+		page, count, err := d.client.ListUsers(ctx, config.Query.ValueString(), int(config.Page.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("List users failed", err.Error())
+			return
+		}
+		users, total = page, count
+	} else {
+		for page := 1; ; page++ {
+			batch, count, err := d.client.ListUsers(ctx, config.Query.ValueString(), page)
+			if err != nil {
+				resp.Diagnostics.AddError("List users failed", err.Error())
+				return
+			}
+			users = append(users, batch...)
+			total = count
+			if len(batch) == 0 || len(users) >= total {
+				break
+			}
+		}
+	}
+
+	groups, err := d.client.ListGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("List groups failed", err.Error())
+		return
+	}
+
+	config.Total = types.Int64Value(int64(total))
+	config.Users = []userEntryModel{}
+	for _, user := range users {
+		if role := config.Role.ValueString(); role != "" && user.Role != role {
+			continue
+		}
+		config.Users = append(config.Users, flattenUserEntry(ctx, user, groups, &resp.Diagnostics))
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
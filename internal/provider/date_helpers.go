@@ -13,3 +13,11 @@ func formatDateValue(ts int64) types.String {
 
 	return types.StringValue(time.Unix(ts, 0).UTC().Format("2006-01-02"))
 }
+
+func formatTimestampValue(ts int64) types.String {
+	if ts <= 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(time.Unix(ts, 0).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ ephemeral.EphemeralResource = &sessionTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &sessionTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &sessionTokenEphemeralResource{}
+
+// sessionTokenPrivateKey stores the token of sessions opened by signing in, so Close can sign them out.
+const sessionTokenPrivateKey = "signed_in_token"
+
+// apiKeyPrefix starts every Open WebUI API key.
+const apiKeyPrefix = "sk-"
+
+// sessionTokenEphemeralResource issues short-lived Open WebUI session tokens that are never persisted in state.
+type sessionTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// sessionTokenEphemeralResourceModel maps the ephemeral resource schema data.
+type sessionTokenEphemeralResourceModel struct {
+	Email                  types.String `tfsdk:"email"`
+	Password               types.String `tfsdk:"password"`
+	UseProviderCredentials types.Bool   `tfsdk:"use_provider_credentials"`
+	Token                  types.String `tfsdk:"token"`
+	TokenType              types.String `tfsdk:"token_type"`
+	ExpiresAt              types.Int64  `tfsdk:"expires_at"`
+	UserID                 types.String `tfsdk:"user_id"`
+	Role                   types.String `tfsdk:"role"`
+}
+
+// sessionTokenPrivateData is the JSON document stored in private data.
+type sessionTokenPrivateData struct {
+	Token string `json:"token"`
+}
+
+// NewSessionTokenEphemeralResource constructs a new ephemeral resource instance.
+func NewSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &sessionTokenEphemeralResource{}
+}
+
+// Metadata sets the ephemeral resource type name.
+func (e *sessionTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_token"
+}
+
+// Schema describes the session token schema.
+func (e *sessionTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Description: "Email address to sign in with. Exactly one of `email` or `use_provider_credentials` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("use_provider_credentials")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to sign in with.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("email")),
+				},
+			},
+			"use_provider_credentials": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Return the session of the provider's own credentials instead of signing in. Only session JWTs that expire are handed out; API keys are refused. The session is not signed out on close.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Session JWT issued by Open WebUI.",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Token type reported by Open WebUI, typically `Bearer`.",
+			},
+			"expires_at": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix timestamp at which the token expires, when Open WebUI reports one.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the user the token belongs to.",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role of the user the token belongs to.",
+			},
+		},
+	}
+}
+
+// Configure attaches the provider client.
+func (e *sessionTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		e.client = data.client
+	}
+}
+
+// Open signs in, or exchanges the provider credentials when explicitly requested, and
+// returns the session token. The provider credentials are only handed out when they are
+// a session JWT that expires, never a long-lived API key.
+func (e *sessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before opening session tokens.")
+		return
+	}
+
+	var config sessionTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	signIn := config.Email.ValueString() != ""
+	if !signIn && !config.UseProviderCredentials.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("use_provider_credentials"),
+			"No credentials selected",
+			"Set email and password to sign in, or set use_provider_credentials = true to return the session of the provider credentials.",
+		)
+		return
+	}
+
+	var (
+		session *client.SessionUser
+		err     error
+	)
+	if signIn {
+		session, err = e.client.WithToken("").Signin(ctx, client.SigninForm{
+			Email:    strings.TrimSpace(config.Email.ValueString()),
+			Password: config.Password.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Sign in failed", err.Error())
+			return
+		}
+	} else {
+		session, err = e.client.GetSessionUser(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Read session user failed", err.Error())
+			return
+		}
+		if strings.HasPrefix(session.Token, apiKeyPrefix) || session.ExpiresAt == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_provider_credentials"),
+				"Provider credentials are not a short-lived session",
+				"The provider authenticates with an API key or a session token that does not expire, which is not handed out. Sign in with email and password instead.",
+			)
+			return
+		}
+	}
+
+	if session.Token == "" {
+		resp.Diagnostics.AddError("Session token unavailable", "Open WebUI did not return a token for the session.")
+		return
+	}
+
+	result := sessionTokenEphemeralResourceModel{
+		Email:                  config.Email,
+		Password:               config.Password,
+		UseProviderCredentials: config.UseProviderCredentials,
+		Token:                  types.StringValue(session.Token),
+		TokenType:              types.StringValue(session.TokenType),
+		ExpiresAt:              types.Int64Null(),
+		UserID:                 types.StringValue(session.ID),
+		Role:                   types.StringValue(session.Role),
+	}
+	if session.ExpiresAt != nil {
+		result.ExpiresAt = types.Int64Value(*session.ExpiresAt)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only sessions opened here are signed out; the provider's own session stays valid.
+	if !signIn {
+		return
+	}
+
+	data, err := json.Marshal(sessionTokenPrivateData{Token: session.Token})
+	if err != nil {
+		resp.Diagnostics.AddError("Serialize private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, sessionTokenPrivateKey, data)...)
+}
+
+// Close signs out sessions that were opened by signing in.
+func (e *sessionTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if e.client == nil {
+		return
+	}
+
+	raw, diags := req.Private.GetKey(ctx, sessionTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(raw) == 0 {
+		return
+	}
+
+	var data sessionTokenPrivateData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		resp.Diagnostics.AddError("Decode private data", err.Error())
+		return
+	}
+	if data.Token == "" {
+		return
+	}
+
+	if err := e.client.WithToken(data.Token).Signout(ctx); err != nil && err != client.ErrNotFound {
+		resp.Diagnostics.AddWarning("Sign out failed", err.Error())
+	}
+}
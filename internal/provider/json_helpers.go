@@ -3,8 +3,11 @@ package provider
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -47,3 +50,126 @@ func encodeOptionalJSON(data map[string]any) (types.String, error) {
 
 	return types.StringValue(string(encoded)), nil
 }
+
+// mergeJSONObjects deep-merges overlay onto base. Nested objects are merged key by key,
+// while arrays and scalar values in overlay replace those in base.
+func mergeJSONObjects(base, overlay map[string]any) map[string]any {
+	result := make(map[string]any, len(base)+len(overlay))
+	for k, v := range base {
+		result[k] = v
+	}
+
+	for k, v := range overlay {
+		overlayMap, overlayIsMap := v.(map[string]any)
+		baseMap, baseIsMap := result[k].(map[string]any)
+		if overlayIsMap && baseIsMap {
+			result[k] = mergeJSONObjects(baseMap, overlayMap)
+			continue
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// projectJSONObject returns the parts of source addressed by the keys present in shape,
+// recursing into nested objects. Keys missing from source are omitted.
+func projectJSONObject(source, shape map[string]any) map[string]any {
+	result := make(map[string]any, len(shape))
+	for k, v := range shape {
+		current, ok := source[k]
+		if !ok {
+			continue
+		}
+
+		shapeMap, shapeIsMap := v.(map[string]any)
+		currentMap, currentIsMap := current.(map[string]any)
+		if shapeIsMap && currentIsMap {
+			result[k] = projectJSONObject(currentMap, shapeMap)
+			continue
+		}
+		result[k] = current
+	}
+
+	return result
+}
+
+// diffJSONPaths lists the dotted paths whose values differ between before and after.
+// Nested objects are compared key by key; any other value is compared as a whole.
+func diffJSONPaths(before, after map[string]any, prefix string) []string {
+	var paths []string
+
+	keys := make(map[string]struct{}, len(before)+len(after))
+	for k := range before {
+		keys[k] = struct{}{}
+	}
+	for k := range after {
+		keys[k] = struct{}{}
+	}
+
+	for k := range keys {
+		keyPath := k
+		if prefix != "" {
+			keyPath = prefix + "." + k
+		}
+
+		beforeValue, inBefore := before[k]
+		afterValue, inAfter := after[k]
+		if !inBefore || !inAfter {
+			paths = append(paths, keyPath)
+			continue
+		}
+
+		beforeMap, beforeIsMap := beforeValue.(map[string]any)
+		afterMap, afterIsMap := afterValue.(map[string]any)
+		if beforeIsMap && afterIsMap {
+			paths = append(paths, diffJSONPaths(beforeMap, afterMap, keyPath)...)
+			continue
+		}
+
+		if !reflect.DeepEqual(beforeValue, afterValue) {
+			paths = append(paths, keyPath)
+		}
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// projectNormalizedJSON encodes the parts of current that prior configures, so keys
+// added by the server do not show up as drift. A null prior yields the whole document.
+func projectNormalizedJSON(current map[string]any, prior jsontypes.Normalized, attribute path.Path, diags *diag.Diagnostics) jsontypes.Normalized {
+	observed := current
+	if !prior.IsNull() && !prior.IsUnknown() {
+		shape := decodeOptionalJSON(prior.StringValue, attribute, diags)
+		if diags.HasError() {
+			return prior
+		}
+		observed = projectJSONObject(current, shape)
+	} else if len(current) == 0 {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	encoded, err := json.Marshal(observed)
+	if err != nil {
+		diags.AddAttributeError(attribute, "Serialize JSON failed", err.Error())
+		return prior
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
+
+// normalizedJSONValue serializes a document returned by the server for a computed JSON
+// attribute. Nil documents yield null.
+func normalizedJSONValue(data any, attribute path.Path, diags *diag.Diagnostics) jsontypes.Normalized {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		diags.AddAttributeError(attribute, "Serialize JSON failed", err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+	if string(encoded) == "null" {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeJSONObjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    map[string]any
+		overlay map[string]any
+		want    map[string]any
+	}{
+		{
+			name:    "empty overlay keeps base",
+			base:    map[string]any{"a": 1.0},
+			overlay: map[string]any{},
+			want:    map[string]any{"a": 1.0},
+		},
+		{
+			name:    "nil base",
+			base:    nil,
+			overlay: map[string]any{"a": 1.0},
+			want:    map[string]any{"a": 1.0},
+		},
+		{
+			name:    "scalar replaces scalar",
+			base:    map[string]any{"a": 1.0, "b": "keep"},
+			overlay: map[string]any{"a": 2.0},
+			want:    map[string]any{"a": 2.0, "b": "keep"},
+		},
+		{
+			name:    "nested objects merge key by key",
+			base:    map[string]any{"ui": map[string]any{"title": "old", "theme": "dark"}},
+			overlay: map[string]any{"ui": map[string]any{"title": "new"}},
+			want:    map[string]any{"ui": map[string]any{"title": "new", "theme": "dark"}},
+		},
+		{
+			name:    "arrays replace arrays",
+			base:    map[string]any{"list": []any{"a", "b"}},
+			overlay: map[string]any{"list": []any{"c"}},
+			want:    map[string]any{"list": []any{"c"}},
+		},
+		{
+			name:    "object replaces scalar",
+			base:    map[string]any{"a": "text"},
+			overlay: map[string]any{"a": map[string]any{"b": true}},
+			want:    map[string]any{"a": map[string]any{"b": true}},
+		},
+		{
+			name:    "null replaces object",
+			base:    map[string]any{"a": map[string]any{"b": true}},
+			overlay: map[string]any{"a": nil},
+			want:    map[string]any{"a": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeJSONObjects(tt.base, tt.overlay)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeJSONObjects() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeJSONObjectsLeavesInputsUntouched(t *testing.T) {
+	base := map[string]any{"ui": map[string]any{"title": "old"}}
+	overlay := map[string]any{"ui": map[string]any{"title": "new"}}
+
+	mergeJSONObjects(base, overlay)
+
+	if got := base["ui"].(map[string]any)["title"]; got != "old" {
+		t.Errorf("base was modified: title = %v", got)
+	}
+}
+
+func TestProjectJSONObject(t *testing.T) {
+	source := map[string]any{
+		"ui": map[string]any{"title": "Open WebUI", "theme": "dark"},
+		"auth": map[string]any{
+			"jwt": map[string]any{"expires_in": "4w"},
+		},
+		"list": []any{"a", "b"},
+		"flag": true,
+	}
+
+	tests := []struct {
+		name  string
+		shape map[string]any
+		want  map[string]any
+	}{
+		{
+			name:  "empty shape",
+			shape: map[string]any{},
+			want:  map[string]any{},
+		},
+		{
+			name:  "selects configured nested keys",
+			shape: map[string]any{"ui": map[string]any{"title": "ignored"}},
+			want:  map[string]any{"ui": map[string]any{"title": "Open WebUI"}},
+		},
+		{
+			name:  "deeply nested",
+			shape: map[string]any{"auth": map[string]any{"jwt": map[string]any{"expires_in": nil}}},
+			want:  map[string]any{"auth": map[string]any{"jwt": map[string]any{"expires_in": "4w"}}},
+		},
+		{
+			name:  "scalar in shape takes the whole value",
+			shape: map[string]any{"ui": "ignored", "list": nil},
+			want:  map[string]any{"ui": map[string]any{"title": "Open WebUI", "theme": "dark"}, "list": []any{"a", "b"}},
+		},
+		{
+			name:  "object in shape over a scalar takes the scalar",
+			shape: map[string]any{"flag": map[string]any{"nested": true}},
+			want:  map[string]any{"flag": true},
+		},
+		{
+			name:  "keys missing from source are omitted",
+			shape: map[string]any{"missing": true, "ui": map[string]any{"missing": true}},
+			want:  map[string]any{"ui": map[string]any{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectJSONObject(source, tt.shape)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("projectJSONObject() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffJSONPaths(t *testing.T) {
+	tests := []struct {
+		name   string
+		before map[string]any
+		after  map[string]any
+		prefix string
+		want   []string
+	}{
+		{
+			name:   "identical",
+			before: map[string]any{"a": 1.0, "b": map[string]any{"c": "x"}},
+			after:  map[string]any{"a": 1.0, "b": map[string]any{"c": "x"}},
+			want:   nil,
+		},
+		{
+			name:   "changed scalar",
+			before: map[string]any{"a": 1.0},
+			after:  map[string]any{"a": 2.0},
+			want:   []string{"a"},
+		},
+		{
+			name:   "added and removed keys",
+			before: map[string]any{"old": true},
+			after:  map[string]any{"new": true},
+			want:   []string{"new", "old"},
+		},
+		{
+			name:   "nested change reports the leaf path",
+			before: map[string]any{"ui": map[string]any{"title": "a", "theme": "dark"}},
+			after:  map[string]any{"ui": map[string]any{"title": "b", "theme": "dark"}},
+			want:   []string{"ui.title"},
+		},
+		{
+			name:   "arrays compare as a whole",
+			before: map[string]any{"list": []any{"a", "b"}},
+			after:  map[string]any{"list": []any{"b", "a"}},
+			want:   []string{"list"},
+		},
+		{
+			name:   "object replaced by scalar",
+			before: map[string]any{"a": map[string]any{"b": true}},
+			after:  map[string]any{"a": "text"},
+			want:   []string{"a"},
+		},
+		{
+			name:   "prefix",
+			before: map[string]any{"b": 1.0, "c": 1.0},
+			after:  map[string]any{"b": 2.0, "c": 1.0},
+			prefix: "root",
+			want:   []string{"root.b"},
+		},
+		{
+			name:   "sorted output",
+			before: map[string]any{"z": 1.0, "a": map[string]any{"y": 1.0, "b": 1.0}},
+			after:  map[string]any{"z": 2.0, "a": map[string]any{"y": 2.0, "b": 2.0}},
+			want:   []string{"a.b", "a.y", "z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffJSONPaths(tt.before, tt.after, tt.prefix)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffJSONPaths() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+const (
+	defaultFileProcessingTimeout = "10m"
+	fileProcessingPollInterval   = 2 * time.Second
+)
+
+// parseProcessingTimeout converts the processing_timeout attribute into a duration,
+// falling back to the default when unset.
+func parseProcessingTimeout(value types.String) time.Duration {
+	raw := defaultFileProcessingTimeout
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		raw = value.ValueString()
+	}
+
+	parsed, err := time.ParseDuration(raw)
+	if err != nil || parsed <= 0 {
+		parsed, _ = time.ParseDuration(defaultFileProcessingTimeout)
+	}
+
+	return parsed
+}
+
+// scanKnowledgeSource walks directory and returns the SHA-256 content hash of every
+// file whose slash-separated relative path matches pattern.
+func scanKnowledgeSource(directory, pattern string) (map[string]string, error) {
+	info, err := os.Stat(directory)
+	if err != nil {
+		return nil, fmt.Errorf("read source directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source directory %q is not a directory", directory)
+	}
+
+	hashes := make(map[string]string)
+	err = filepath.WalkDir(directory, func(current string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(directory, current)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		matched, err := matchSourcePattern(pattern, rel)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if !matched {
+			return nil
+		}
+
+		content, err := os.ReadFile(current)
+		if err != nil {
+			return err
+		}
+
+		hashes[rel] = hashSourceContent(content)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// matchSourcePattern reports whether name matches pattern. Segments are matched with
+// path.Match, and a "**" segment matches zero or more directories.
+func matchSourcePattern(pattern, name string) (bool, error) {
+	return matchSourceSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSourceSegments(pattern, parts []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			if len(pattern) == 1 {
+				return true, nil
+			}
+			for i := 0; i <= len(parts); i++ {
+				matched, err := matchSourceSegments(pattern[1:], parts[i:])
+				if err != nil || matched {
+					return matched, err
+				}
+			}
+			return false, nil
+		}
+
+		if len(parts) == 0 {
+			return false, nil
+		}
+
+		matched, err := path.Match(pattern[0], parts[0])
+		if err != nil || !matched {
+			return false, err
+		}
+
+		pattern = pattern[1:]
+		parts = parts[1:]
+	}
+
+	return len(parts) == 0, nil
+}
+
+func hashSourceContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// syncKnowledgeSource uploads new and changed files, re-attaches them to the knowledge
+// entry and detaches files that no longer exist locally. It returns the path to file
+// ID and path to hash mappings that were actually applied, which only differ from the
+// desired state when an error is recorded in diags.
+func syncKnowledgeSource(ctx context.Context, apiClient *client.Client, knowledgeID, directory string, desired, priorHashes, priorFiles map[string]string, timeout time.Duration, diags *diag.Diagnostics) (map[string]string, map[string]string) {
+	files := make(map[string]string, len(desired))
+	hashes := make(map[string]string, len(desired))
+	for rel, id := range priorFiles {
+		files[rel] = id
+		hashes[rel] = priorHashes[rel]
+	}
+
+	paths := make([]string, 0, len(desired))
+	for rel := range desired {
+		paths = append(paths, rel)
+	}
+	sort.Strings(paths)
+
+	for _, rel := range paths {
+		hash := desired[rel]
+		previousID := priorFiles[rel]
+		if previousID != "" && priorHashes[rel] == hash {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(directory, filepath.FromSlash(rel)))
+		if err != nil {
+			diags.AddError("Read source file failed", fmt.Sprintf("Unable to read %s: %v", rel, err))
+			return files, hashes
+		}
+		if hashSourceContent(content) != hash {
+			diags.AddError(
+				"Source file changed during apply",
+				fmt.Sprintf("The content of %s changed after the plan was created. Run terraform apply again to pick up the new content.", rel),
+			)
+			return files, hashes
+		}
+
+		uploaded, err := apiClient.UploadFile(ctx, path.Base(rel), content)
+		if err != nil {
+			diags.AddError("Upload source file failed", fmt.Sprintf("Unable to upload %s: %v", rel, err))
+			return files, hashes
+		}
+
+		if err := apiClient.WaitForFileProcessing(ctx, uploaded.ID, timeout, fileProcessingPollInterval); err != nil {
+			diags.AddError("Source file processing failed", fmt.Sprintf("Open WebUI could not process %s: %v", rel, err))
+			_ = apiClient.DeleteFile(ctx, uploaded.ID)
+			return files, hashes
+		}
+
+		// Open WebUI embeds the file into the knowledge collection before the attach
+		// call returns, so the entry it returns reflects the outcome.
+		attached, err := apiClient.AddKnowledgeFile(ctx, knowledgeID, uploaded.ID)
+		if err != nil {
+			diags.AddError("Attach source file failed", fmt.Sprintf("Unable to attach %s to knowledge entry %s: %v", rel, knowledgeID, err))
+			_ = apiClient.DeleteFile(ctx, uploaded.ID)
+			return files, hashes
+		}
+		if !knowledgeHasFile(attached, uploaded.ID) {
+			diags.AddError("Attach source file failed", fmt.Sprintf("Open WebUI did not add %s to knowledge entry %s.", rel, knowledgeID))
+			_ = apiClient.DeleteFile(ctx, uploaded.ID)
+			return files, hashes
+		}
+
+		files[rel] = uploaded.ID
+		hashes[rel] = hash
+
+		if previousID != "" {
+			if err := detachKnowledgeFile(ctx, apiClient, knowledgeID, previousID); err != nil {
+				diags.AddWarning(
+					"Detach previous source file failed",
+					fmt.Sprintf("The new version of %s was attached, but the previous file %s could not be removed: %v", rel, previousID, err),
+				)
+			}
+		}
+	}
+
+	for rel, fileID := range priorFiles {
+		if _, ok := desired[rel]; ok {
+			continue
+		}
+
+		if err := detachKnowledgeFile(ctx, apiClient, knowledgeID, fileID); err != nil {
+			diags.AddError("Detach source file failed", fmt.Sprintf("Unable to remove %s: %v", rel, err))
+			return files, hashes
+		}
+
+		delete(files, rel)
+		delete(hashes, rel)
+	}
+
+	return files, hashes
+}
+
+// detachKnowledgeFile removes a file from a knowledge entry and deletes the file record.
+func detachKnowledgeFile(ctx context.Context, apiClient *client.Client, knowledgeID, fileID string) error {
+	if _, err := apiClient.RemoveKnowledgeFile(ctx, knowledgeID, fileID); err != nil && err != client.ErrNotFound {
+		return err
+	}
+
+	if err := apiClient.DeleteFile(ctx, fileID); err != nil && err != client.ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// planKnowledgeSourceFiles predicts the path to file ID mapping, keeping IDs for
+// unchanged files and marking new or changed files as unknown.
+func planKnowledgeSourceFiles(hashes, priorHashes, priorFiles map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(hashes))
+	for rel, hash := range hashes {
+		if id, ok := priorFiles[rel]; ok && priorHashes[rel] == hash {
+			elements[rel] = types.StringValue(id)
+			continue
+		}
+		elements[rel] = types.StringUnknown()
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// filterAttachedSourceFiles drops source entries whose files are no longer attached
+// to the knowledge entry so the next plan re-uploads them.
+func filterAttachedSourceFiles(files, hashes map[string]string, attached []client.FileModel) (map[string]string, map[string]string) {
+	present := make(map[string]struct{}, len(attached))
+	for _, f := range attached {
+		present[f.ID] = struct{}{}
+	}
+
+	keptFiles := make(map[string]string, len(files))
+	keptHashes := make(map[string]string, len(hashes))
+	for rel, id := range files {
+		if _, ok := present[id]; !ok {
+			continue
+		}
+		keptFiles[rel] = id
+		if hash, ok := hashes[rel]; ok {
+			keptHashes[rel] = hash
+		}
+	}
+
+	return keptFiles, keptHashes
+}
+
+// knowledgeHasFile reports whether a knowledge entry returned by the API lists fileID.
+func knowledgeHasFile(knowledge *client.KnowledgeFilesResponse, fileID string) bool {
+	if knowledge == nil {
+		return false
+	}
+
+	for _, file := range knowledge.Files {
+		if file.ID == fileID {
+			return true
+		}
+	}
+
+	return false
+}
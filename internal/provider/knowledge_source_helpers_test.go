@@ -0,0 +1,41 @@
+package provider
+
+import "testing"
+
+func TestMatchSourcePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+		wantErr bool
+	}{
+		{name: "single segment", pattern: "*.md", path: "readme.md", want: true},
+		{name: "single segment does not cross directories", pattern: "*.md", path: "docs/readme.md", want: false},
+		{name: "explicit directory", pattern: "docs/*.md", path: "docs/readme.md", want: true},
+		{name: "double star matches zero directories", pattern: "**/*.md", path: "readme.md", want: true},
+		{name: "double star matches one directory", pattern: "**/*.md", path: "docs/readme.md", want: true},
+		{name: "double star matches nested directories", pattern: "**/*.md", path: "docs/guides/setup/readme.md", want: true},
+		{name: "double star checks the extension", pattern: "**/*.md", path: "docs/readme.txt", want: false},
+		{name: "trailing double star matches everything below", pattern: "docs/**", path: "docs/guides/readme.md", want: true},
+		{name: "trailing double star requires the prefix", pattern: "docs/**", path: "src/readme.md", want: false},
+		{name: "double star in the middle", pattern: "docs/**/setup.md", path: "docs/setup.md", want: true},
+		{name: "double star in the middle nested", pattern: "docs/**/setup.md", path: "docs/a/b/setup.md", want: true},
+		{name: "double star in the middle wrong name", pattern: "docs/**/setup.md", path: "docs/a/b/install.md", want: false},
+		{name: "pattern longer than path", pattern: "docs/guides/*.md", path: "docs/readme.md", want: false},
+		{name: "path longer than pattern", pattern: "docs", path: "docs/readme.md", want: false},
+		{name: "malformed pattern", pattern: "[", path: "readme.md", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchSourcePattern(tt.pattern, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchSourcePattern(%q, %q) error = %v, wantErr %v", tt.pattern, tt.path, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("matchSourcePattern(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
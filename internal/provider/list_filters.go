@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+// listFilterModel holds the filters shared by the plural data sources.
+type listFilterModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Owner     types.String `tfsdk:"owner"`
+}
+
+// accessFilterModel adds the access group filter of access-controlled records.
+type accessFilterModel struct {
+	listFilterModel
+	AccessGroup types.String `tfsdk:"access_group"`
+}
+
+// listFilterAttributes returns the schema of listFilterModel. nameDescription says which
+// field name_regex is matched against.
+func listFilterAttributes(nameDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name_regex": schema.StringAttribute{
+			Optional:    true,
+			Description: "Regular expression that " + nameDescription + " must match.",
+			Validators: []validator.String{
+				regexValidator{},
+			},
+		},
+		"owner": schema.StringAttribute{
+			Optional:    true,
+			Description: "Email address, username or ID of the owning user, resolved with the provider's `user_match` mode.",
+		},
+	}
+}
+
+// accessFilterAttributes returns the schema of accessFilterModel.
+func accessFilterAttributes(nameDescription string) map[string]schema.Attribute {
+	attributes := listFilterAttributes(nameDescription)
+	attributes["access_group"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Name or ID of a group; only records granting it read or write access are returned. Public records do not match.",
+	}
+	return attributes
+}
+
+// listFilter is the resolved form of the configured filters.
+type listFilter struct {
+	name    *regexp.Regexp
+	ownerID string
+	groupID string
+}
+
+// newListFilter compiles the name pattern and resolves the owner.
+func newListFilter(ctx context.Context, apiClient *client.Client, match string, config listFilterModel, diags *diag.Diagnostics) listFilter {
+	var filter listFilter
+
+	if value := config.NameRegex.ValueString(); value != "" {
+		pattern, err := regexp.Compile(value)
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return filter
+		}
+		filter.name = pattern
+	}
+
+	if value := config.Owner.ValueString(); value != "" {
+		ids := resolveUserIDs(ctx, apiClient, []string{value}, match, path.Root("owner"), diags)
+		if len(ids) > 0 {
+			filter.ownerID = ids[0]
+		}
+	}
+
+	return filter
+}
+
+// newAccessFilter resolves the shared filters and the access group.
+func newAccessFilter(ctx context.Context, apiClient *client.Client, match string, config accessFilterModel, diags *diag.Diagnostics) listFilter {
+	filter := newListFilter(ctx, apiClient, match, config.listFilterModel, diags)
+
+	if value := config.AccessGroup.ValueString(); value != "" {
+		ids := resolveGroupNamesToIDs(ctx, apiClient, []string{value}, path.Root("access_group"), diags)
+		if len(ids) > 0 {
+			filter.groupID = ids[0]
+		}
+	}
+
+	return filter
+}
+
+// matches reports whether a record passes the filters. Any of names may match name_regex.
+func (f listFilter) matches(ownerID string, access map[string]any, names ...string) bool {
+	if f.ownerID != "" && f.ownerID != ownerID {
+		return false
+	}
+
+	if f.groupID != "" && !slices.Contains(extractGroupIDsFromAccessControl(access, "read"), f.groupID) && !slices.Contains(extractGroupIDsFromAccessControl(access, "write"), f.groupID) {
+		return false
+	}
+
+	if f.name == nil {
+		return true
+	}
+	for _, name := range names {
+		if f.name.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listAccessModel exposes the access settings of a listed record.
+type listAccessModel struct {
+	Visibility    types.String `tfsdk:"visibility"`
+	ReadGroupIDs  types.Set    `tfsdk:"read_group_ids"`
+	WriteGroupIDs types.Set    `tfsdk:"write_group_ids"`
+}
+
+// listAccessAttributes returns the schema of listAccessModel.
+func listAccessAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"visibility": schema.StringAttribute{
+			Computed:    true,
+			Description: "Who can access the record: `public`, `private` or `restricted`.",
+		},
+		"read_group_ids": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Group IDs granted read access.",
+		},
+		"write_group_ids": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Group IDs granted write access.",
+		},
+	}
+}
+
+// flattenListAccess converts an access_control document for a listed record.
+func flattenListAccess(ctx context.Context, access map[string]any, diags *diag.Diagnostics) listAccessModel {
+	readIDs, readDiags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(uniqueStrings(extractGroupIDsFromAccessControl(access, "read"))))
+	diags.Append(readDiags...)
+	writeIDs, writeDiags := types.SetValueFrom(ctx, types.StringType, nonNilStrings(uniqueStrings(extractGroupIDsFromAccessControl(access, "write"))))
+	diags.Append(writeDiags...)
+
+	return listAccessModel{
+		Visibility:    flattenVisibility(access, types.StringNull()),
+		ReadGroupIDs:  readIDs,
+		WriteGroupIDs: writeIDs,
+	}
+}
+
+// mergeAttributes combines schema attribute maps; later maps win.
+func mergeAttributes(sets ...map[string]schema.Attribute) map[string]schema.Attribute {
+	merged := make(map[string]schema.Attribute)
+	for _, set := range sets {
+		for name, attribute := range set {
+			merged[name] = attribute
+		}
+	}
+
+	return merged
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -37,3 +38,55 @@ func flattenStringSlice(ctx context.Context, values []string) (types.List, diag.
 	list, diags := types.ListValueFrom(ctx, types.StringType, values)
 	return list, diags
 }
+
+// expandStringSet converts a Terraform set attribute into a Go slice of strings.
+func expandStringSet(ctx context.Context, value types.Set, attribute path.Path, diags *diag.Diagnostics) []string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var result []string
+	if err := value.ElementsAs(ctx, &result, false); err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid string set value",
+			fmt.Sprintf("Unable to decode attribute %s into a set of strings: %v", attribute.String(), err),
+		)
+		return nil
+	}
+
+	return result
+}
+
+// flattenStringSet converts a slice of strings into a Terraform Set value, or null when
+// the slice is empty.
+func flattenStringSet(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	set, diags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(values))
+	return set, diags
+}
+
+// expandStringMap converts a known Terraform map of strings into a Go map.
+func expandStringMap(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var result map[string]string
+	diags.Append(value.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+// sortedKeys returns the keys of a map in lexical order.
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -4,10 +4,22 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+// Presets accepted by permissions.base besides a group ID.
+const (
+	groupPermissionsBaseDefaults = "defaults"
+	groupPermissionsBaseNone     = "none"
+	groupPermissionsBaseAll      = "all"
 )
 
 var (
@@ -16,16 +28,66 @@ var (
 	groupPermissionsChatKeys      = []string{"controls", "valves", "system_prompt", "params", "file_upload", "delete", "delete_message", "continue_response", "regenerate_response", "rate_response", "edit", "share", "export", "stt", "tts", "call", "multiple_models", "temporary", "temporary_enforced"}
 	groupPermissionsFeaturesKeys  = []string{"direct_tool_servers", "web_search", "image_generation", "code_interpreter", "notes"}
 
-	groupPermissionsAllowedSets = map[string]map[string]struct{}{
-		"workspace": sliceToSet(groupPermissionsWorkspaceKeys),
-		"sharing":   sliceToSet(groupPermissionsSharingKeys),
-		"chat":      sliceToSet(groupPermissionsChatKeys),
-		"features":  sliceToSet(groupPermissionsFeaturesKeys),
+	// groupPermissionsBuiltinKeys are the keys known when the provider was released. They
+	// are merged with the keys reported by the server.
+	groupPermissionsBuiltinKeys = map[string][]string{
+		"workspace": groupPermissionsWorkspaceKeys,
+		"sharing":   groupPermissionsSharingKeys,
+		"chat":      groupPermissionsChatKeys,
+		"features":  groupPermissionsFeaturesKeys,
 	}
+
+	// groupPermissionFlagsType is the element type of maps keyed by permission category,
+	// such as permissions.additional and effective_permissions.
+	groupPermissionFlagsType = types.MapType{ElemType: types.BoolType}
 )
 
+// permissionCatalog caches the permission keys Open WebUI supports, as reported by
+// /users/default/permissions. It is shared by all resources of a provider instance.
+type permissionCatalog struct {
+	mu     sync.Mutex
+	loaded bool
+	keys   map[string]map[string]struct{}
+}
+
+// load returns the supported keys by category, fetching them on first use.
+func (c *permissionCatalog) load(ctx context.Context, apiClient *client.Client) (map[string]map[string]struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return c.keys, nil
+	}
+
+	defaults, err := apiClient.GetDefaultPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]map[string]struct{}, len(groupPermissionsBuiltinKeys)+len(defaults))
+	for category, list := range groupPermissionsBuiltinKeys {
+		keys[category] = sliceToSet(list)
+	}
+	for category, raw := range defaults {
+		nested, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if keys[category] == nil {
+			keys[category] = make(map[string]struct{}, len(nested))
+		}
+		for key := range nested {
+			keys[category][key] = struct{}{}
+		}
+	}
+
+	c.keys = keys
+	c.loaded = true
+	return keys, nil
+}
+
 func permissionsSpecified(perms groupPermissionsModel) bool {
-	return mapProvided(perms.Workspace) || mapProvided(perms.Sharing) || mapProvided(perms.Chat) || mapProvided(perms.Features)
+	return mapProvided(perms.Workspace) || mapProvided(perms.Sharing) || mapProvided(perms.Chat) || mapProvided(perms.Features) || mapProvided(perms.Additional)
 }
 
 func mapProvided(value types.Map) bool {
@@ -59,13 +121,12 @@ func expandPermissions(ctx context.Context, perms groupPermissionsModel, diags *
 			return
 		}
 
-		filtered := filterPermissionKeys(category, bools, attribute, diags)
-		if len(filtered) == 0 {
+		if len(bools) == 0 {
 			return
 		}
 
-		nested := make(map[string]any, len(filtered))
-		for k, v := range filtered {
+		nested := make(map[string]any, len(bools))
+		for k, v := range bools {
 			nested[k] = v
 		}
 
@@ -77,6 +138,14 @@ func expandPermissions(ctx context.Context, perms groupPermissionsModel, diags *
 	add("chat", perms.Chat, path.Root("permissions").AtName("chat"))
 	add("features", perms.Features, path.Root("permissions").AtName("features"))
 
+	if mapProvided(perms.Additional) {
+		for category, value := range perms.Additional.Elements() {
+			if nested, ok := value.(types.Map); ok {
+				add(category, nested, path.Root("permissions").AtName("additional").AtMapKey(category))
+			}
+		}
+	}
+
 	if len(result) == 0 {
 		return nil
 	}
@@ -86,10 +155,11 @@ func expandPermissions(ctx context.Context, perms groupPermissionsModel, diags *
 
 func flattenPermissions(ctx context.Context, perms map[string]any) (groupPermissionsModel, diag.Diagnostics) {
 	model := groupPermissionsModel{
-		Workspace: types.MapNull(types.BoolType),
-		Sharing:   types.MapNull(types.BoolType),
-		Chat:      types.MapNull(types.BoolType),
-		Features:  types.MapNull(types.BoolType),
+		Workspace:  types.MapNull(types.BoolType),
+		Sharing:    types.MapNull(types.BoolType),
+		Chat:       types.MapNull(types.BoolType),
+		Features:   types.MapNull(types.BoolType),
+		Additional: types.MapNull(groupPermissionFlagsType),
 	}
 
 	var diags diag.Diagnostics
@@ -98,6 +168,20 @@ func flattenPermissions(ctx context.Context, perms map[string]any) (groupPermiss
 		return model, diags
 	}
 
+	additional := make(map[string]map[string]bool)
+	for category, raw := range perms {
+		if _, typed := groupPermissionsBuiltinKeys[category]; typed || raw == nil {
+			continue
+		}
+
+		nested, ok := raw.(map[string]any)
+		if !ok {
+			tflog.Warn(ctx, "Ignoring group permission category that is not an object", map[string]any{"category": category})
+			continue
+		}
+		additional[category] = permissionResponseBools(ctx, category, nested)
+	}
+
 	convert := func(category string) types.Map {
 		raw, ok := perms[category]
 		if !ok || raw == nil {
@@ -113,7 +197,7 @@ func flattenPermissions(ctx context.Context, perms map[string]any) (groupPermiss
 			return types.MapNull(types.BoolType)
 		}
 
-		bools := filterPermissionResponse(category, nested, &diags)
+		bools := permissionResponseBools(ctx, category, nested)
 		tfMap, mapDiags := types.MapValueFrom(ctx, types.BoolType, bools)
 		diags.Append(mapDiags...)
 		return tfMap
@@ -124,90 +208,312 @@ func flattenPermissions(ctx context.Context, perms map[string]any) (groupPermiss
 	model.Chat = convert("chat")
 	model.Features = convert("features")
 
+	if len(additional) > 0 {
+		tfMap, mapDiags := types.MapValueFrom(ctx, groupPermissionFlagsType, additional)
+		diags.Append(mapDiags...)
+		model.Additional = tfMap
+	}
+
 	return model, diags
 }
 
-func filterPermissionKeys(category string, bools map[string]bool, attribute path.Path, diags *diag.Diagnostics) map[string]bool {
-	allowed, ok := groupPermissionsAllowedSets[category]
-	if !ok {
-		diags.AddError(
-			"Internal provider error",
-			fmt.Sprintf("Unknown permission category %s", category),
+// permissionResponseBools keeps every boolean flag of a category. Keys the provider does
+// not know are kept so new Open WebUI permissions never break a refresh.
+func permissionResponseBools(ctx context.Context, category string, nested map[string]any) map[string]bool {
+	bools := make(map[string]bool, len(nested))
+	for key, raw := range nested {
+		boolVal, ok := raw.(bool)
+		if !ok {
+			tflog.Warn(ctx, "Ignoring group permission that is not a boolean", map[string]any{
+				"category": category,
+				"key":      key,
+			})
+			continue
+		}
+
+		bools[key] = boolVal
+	}
+
+	return bools
+}
+
+// validatePermissionKeys checks configured permission keys against the keys reported by
+// the server. When the server cannot be asked, the keys are sent unchecked with a warning.
+func validatePermissionKeys(ctx context.Context, apiClient *client.Client, catalog *permissionCatalog, perms groupPermissionsModel, diags *diag.Diagnostics) {
+	if !permissionsSpecified(perms) || catalog == nil {
+		return
+	}
+
+	keys, err := catalog.load(ctx, apiClient)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to verify permission keys",
+			fmt.Sprintf("Reading /users/default/permissions failed, so group permission keys are sent without validation: %v", err),
 		)
-		return nil
+		return
+	}
+
+	check := func(category string, value types.Map, attribute path.Path) {
+		if !mapProvided(value) {
+			return
+		}
+
+		allowed := keys[category]
+		for key := range value.Elements() {
+			if _, ok := allowed[key]; ok {
+				continue
+			}
+			diags.AddAttributeError(
+				attribute.AtMapKey(key),
+				fmt.Sprintf("Unsupported %s permission key", category),
+				fmt.Sprintf("Open WebUI does not define the %s permission %q. Supported keys: %s.", category, key, joinSortedKeys(allowed)),
+			)
+		}
 	}
 
-	allowedList := allowedKeysList(category)
+	check("workspace", perms.Workspace, path.Root("permissions").AtName("workspace"))
+	check("sharing", perms.Sharing, path.Root("permissions").AtName("sharing"))
+	check("chat", perms.Chat, path.Root("permissions").AtName("chat"))
+	check("features", perms.Features, path.Root("permissions").AtName("features"))
+
+	if !mapProvided(perms.Additional) {
+		return
+	}
 
-	filtered := make(map[string]bool, len(bools))
-	for key, value := range bools {
-		if _, exists := allowed[key]; !exists {
+	categories := make(map[string]struct{}, len(keys))
+	for category := range keys {
+		categories[category] = struct{}{}
+	}
+
+	for category, value := range perms.Additional.Elements() {
+		attribute := path.Root("permissions").AtName("additional").AtMapKey(category)
+		if _, typed := groupPermissionsBuiltinKeys[category]; typed {
 			diags.AddAttributeError(
 				attribute,
-				fmt.Sprintf("Unsupported %s permission key", category),
-				fmt.Sprintf("Supported keys are: %s. Received %q.", allowedList, key),
+				"Permission category has a dedicated attribute",
+				fmt.Sprintf("Set the %s permissions with permissions.%s instead of permissions.additional.", category, category),
+			)
+			continue
+		}
+		if _, ok := keys[category]; !ok {
+			diags.AddAttributeError(
+				attribute,
+				"Unsupported permission category",
+				fmt.Sprintf("Open WebUI does not define the permission category %q. Supported categories: %s.", category, joinSortedKeys(categories)),
 			)
 			continue
 		}
+		if nested, ok := value.(types.Map); ok {
+			check(category, nested, attribute)
+		}
+	}
+}
+
+// joinSortedKeys renders the keys of a set for diagnostics.
+func joinSortedKeys(values map[string]struct{}) string {
+	return strings.Join(sortedKeys(values), ", ")
+}
 
-		filtered[key] = value
+// permissionsObjectModel decodes a permissions object read from configuration or plan.
+func permissionsObjectModel(ctx context.Context, value types.Object, diags *diag.Diagnostics) (groupResourcePermissionsModel, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return groupResourcePermissionsModel{}, false
 	}
 
-	return filtered
+	var perms groupResourcePermissionsModel
+	diags.Append(value.As(ctx, &perms, basetypes.ObjectAsOptions{})...)
+	return perms, !diags.HasError()
 }
 
-func filterPermissionResponse(category string, nested map[string]any, diags *diag.Diagnostics) map[string]bool {
-	allowed, ok := groupPermissionsAllowedSets[category]
-	if !ok {
-		diags.AddError(
-			"Internal provider error",
-			fmt.Sprintf("Unknown permission category %s", category),
-		)
-		return nil
+// permissionsKnown reports whether the base and every override map are known.
+func permissionsKnown(perms groupResourcePermissionsModel) bool {
+	if perms.Base.IsUnknown() {
+		return false
+	}
+
+	for _, value := range []types.Map{perms.Workspace, perms.Sharing, perms.Chat, perms.Features, perms.Additional} {
+		if value.IsUnknown() {
+			return false
+		}
+		for _, element := range value.Elements() {
+			if element.IsUnknown() {
+				return false
+			}
+			if nested, ok := element.(types.Map); ok {
+				for _, flag := range nested.Elements() {
+					if flag.IsUnknown() {
+						return false
+					}
+				}
+			}
+		}
 	}
 
-	allowedList := allowedKeysList(category)
+	return true
+}
 
-	filtered := make(map[string]bool, len(nested))
-	for key, raw := range nested {
-		boolVal, ok := raw.(bool)
+// permissionFlags converts a permissions document into boolean flags by category,
+// dropping categories without flags.
+func permissionFlags(ctx context.Context, perms map[string]any) map[string]map[string]bool {
+	flags := make(map[string]map[string]bool, len(perms))
+	for category, raw := range perms {
+		nested, ok := raw.(map[string]any)
 		if !ok {
-			diags.AddError(
-				"Unexpected permissions response",
-				fmt.Sprintf("Expected permissions.%s.%s to be a boolean", category, key),
-			)
 			continue
 		}
 
-		if _, exists := allowed[key]; !exists {
-			diags.AddError(
-				"Unexpected permissions response",
-				fmt.Sprintf("Encountered unsupported %s permission %q from API. Supported keys: %s.", category, key, allowedList),
-			)
-			continue
+		bools := permissionResponseBools(ctx, category, nested)
+		if len(bools) > 0 {
+			flags[category] = bools
 		}
+	}
 
-		filtered[key] = boolVal
+	return flags
+}
+
+// permissionFlagsDocument converts flags into the permissions document sent to Open WebUI.
+func permissionFlagsDocument(flags map[string]map[string]bool) map[string]any {
+	if len(flags) == 0 {
+		return nil
 	}
 
-	return filtered
+	document := make(map[string]any, len(flags))
+	for category, bools := range flags {
+		nested := make(map[string]any, len(bools))
+		for key, value := range bools {
+			nested[key] = value
+		}
+		document[category] = nested
+	}
+
+	return document
 }
 
-func allowedKeysList(category string) string {
-	var keys []string
+// flattenPermissionFlags converts flags into the effective_permissions value.
+func flattenPermissionFlags(ctx context.Context, flags map[string]map[string]bool) (types.Map, diag.Diagnostics) {
+	if len(flags) == 0 {
+		return types.MapNull(groupPermissionFlagsType), nil
+	}
+
+	return types.MapValueFrom(ctx, groupPermissionFlagsType, flags)
+}
 
-	switch category {
-	case "workspace":
-		keys = groupPermissionsWorkspaceKeys
-	case "sharing":
-		keys = groupPermissionsSharingKeys
-	case "chat":
-		keys = groupPermissionsChatKeys
-	case "features":
-		keys = groupPermissionsFeaturesKeys
+// resolvePermissionsBase returns the flags a permissions base expands to: the default
+// user permissions, every known key disabled or enabled, or the permissions of a group.
+func resolvePermissionsBase(ctx context.Context, apiClient *client.Client, catalog *permissionCatalog, base string) (map[string]map[string]bool, error) {
+	switch base {
+	case groupPermissionsBaseDefaults:
+		defaults, err := apiClient.GetDefaultPermissions(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("reading default permissions: %w", err)
+		}
+		return permissionFlags(ctx, defaults), nil
+	case groupPermissionsBaseNone, groupPermissionsBaseAll:
+		keys := make(map[string]map[string]struct{}, len(groupPermissionsBuiltinKeys))
+		for category, list := range groupPermissionsBuiltinKeys {
+			keys[category] = sliceToSet(list)
+		}
+		if catalog != nil {
+			loaded, err := catalog.load(ctx, apiClient)
+			if err != nil {
+				return nil, fmt.Errorf("reading permission keys: %w", err)
+			}
+			keys = loaded
+		}
+
+		flags := make(map[string]map[string]bool, len(keys))
+		for category, set := range keys {
+			flags[category] = make(map[string]bool, len(set))
+			for key := range set {
+				flags[category][key] = base == groupPermissionsBaseAll
+			}
+		}
+		return flags, nil
 	default:
-		return ""
+		group, err := apiClient.GetGroup(ctx, base)
+		if err != nil {
+			if err == client.ErrNotFound {
+				return nil, fmt.Errorf("no group has the ID %q; use %q, %q, %q or a group ID", base, groupPermissionsBaseDefaults, groupPermissionsBaseNone, groupPermissionsBaseAll)
+			}
+			return nil, fmt.Errorf("reading group %s: %w", base, err)
+		}
+		return permissionFlags(ctx, group.Permissions), nil
+	}
+}
+
+// effectivePermissions merges the configured overrides into the resolved base. Without a
+// base, the overrides are all that is sent.
+func effectivePermissions(ctx context.Context, apiClient *client.Client, catalog *permissionCatalog, perms groupResourcePermissionsModel, diags *diag.Diagnostics) map[string]map[string]bool {
+	overrides := permissionFlags(ctx, expandPermissions(ctx, perms.groupPermissionsModel, diags))
+	if perms.Base.IsNull() || perms.Base.IsUnknown() {
+		return overrides
+	}
+
+	flags, err := resolvePermissionsBase(ctx, apiClient, catalog, perms.Base.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("permissions").AtName("base"),
+			"Unable to resolve permissions base",
+			err.Error(),
+		)
+		return nil
+	}
+
+	for category, bools := range overrides {
+		if flags[category] == nil {
+			flags[category] = make(map[string]bool, len(bools))
+		}
+		for key, value := range bools {
+			flags[category][key] = value
+		}
+	}
+
+	return flags
+}
+
+// overridePermissions reports the permissions read from Open WebUI as overrides of a base,
+// keeping only the categories and keys listed in prior.
+func overridePermissions(ctx context.Context, flags map[string]map[string]bool, prior groupPermissionsModel, diags *diag.Diagnostics) groupPermissionsModel {
+	pick := func(category string, configured types.Map) map[string]bool {
+		picked := make(map[string]bool, len(configured.Elements()))
+		for key := range configured.Elements() {
+			if value, ok := flags[category][key]; ok {
+				picked[key] = value
+			}
+		}
+		return picked
+	}
+
+	convert := func(category string, configured types.Map) types.Map {
+		if !mapProvided(configured) {
+			return types.MapNull(types.BoolType)
+		}
+
+		tfMap, mapDiags := types.MapValueFrom(ctx, types.BoolType, pick(category, configured))
+		diags.Append(mapDiags...)
+		return tfMap
+	}
+
+	model := groupPermissionsModel{
+		Workspace:  convert("workspace", prior.Workspace),
+		Sharing:    convert("sharing", prior.Sharing),
+		Chat:       convert("chat", prior.Chat),
+		Features:   convert("features", prior.Features),
+		Additional: types.MapNull(groupPermissionFlagsType),
+	}
+
+	if mapProvided(prior.Additional) {
+		additional := make(map[string]map[string]bool, len(prior.Additional.Elements()))
+		for category, value := range prior.Additional.Elements() {
+			if nested, ok := value.(types.Map); ok {
+				additional[category] = pick(category, nested)
+			}
+		}
+
+		tfMap, mapDiags := types.MapValueFrom(ctx, groupPermissionFlagsType, additional)
+		diags.Append(mapDiags...)
+		model.Additional = tfMap
 	}
 
-	return strings.Join(keys, ", ")
+	return model
 }
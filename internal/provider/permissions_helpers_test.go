@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+// newTestClient returns a client for a server that answers GET requests with the JSON
+// encoding of routes[path] and 404 for any other path.
+func newTestClient(t *testing.T, routes map[string]any) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClient(server.URL, "token")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return apiClient
+}
+
+func boolMap(t *testing.T, values map[string]bool) types.Map {
+	t.Helper()
+
+	if values == nil {
+		return types.MapNull(types.BoolType)
+	}
+	m, diags := types.MapValueFrom(context.Background(), types.BoolType, values)
+	if diags.HasError() {
+		t.Fatalf("building map: %v", diags)
+	}
+	return m
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	apiClient := newTestClient(t, map[string]any{
+		"/users/default/permissions": map[string]any{
+			"workspace": map[string]any{"models": false, "tools": true},
+			"chat":      map[string]any{"delete": true, "limit": "not a flag"},
+			"custom":    map[string]any{"beta": true},
+		},
+		"/groups/id/group-1": map[string]any{
+			"id":          "group-1",
+			"permissions": map[string]any{"sharing": map[string]any{"public_models": true}},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		base      types.String
+		workspace map[string]bool
+		wantErr   bool
+		check     func(t *testing.T, got map[string]map[string]bool)
+	}{
+		{
+			name:      "without a base only the overrides are sent",
+			base:      types.StringNull(),
+			workspace: map[string]bool{"models": true},
+			check: func(t *testing.T, got map[string]map[string]bool) {
+				want := map[string]map[string]bool{"workspace": {"models": true}}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("got %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:      "overrides replace keys of the defaults",
+			base:      types.StringValue(groupPermissionsBaseDefaults),
+			workspace: map[string]bool{"models": true},
+			check: func(t *testing.T, got map[string]map[string]bool) {
+				want := map[string]map[string]bool{
+					"workspace": {"models": true, "tools": true},
+					"chat":      {"delete": true},
+					"custom":    {"beta": true},
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("got %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:      "none disables every catalog key except the overrides",
+			base:      types.StringValue(groupPermissionsBaseNone),
+			workspace: map[string]bool{"models": true},
+			check: func(t *testing.T, got map[string]map[string]bool) {
+				if !got["workspace"]["models"] {
+					t.Errorf("workspace.models = false, want the override true")
+				}
+				if got["workspace"]["tools"] {
+					t.Errorf("workspace.tools = true, want false")
+				}
+				if value, ok := got["custom"]["beta"]; !ok || value {
+					t.Errorf("custom.beta = %v (present %v), want false from the catalog", value, ok)
+				}
+			},
+		},
+		{
+			name: "all enables every catalog key",
+			base: types.StringValue(groupPermissionsBaseAll),
+			check: func(t *testing.T, got map[string]map[string]bool) {
+				for category, flags := range got {
+					for key, value := range flags {
+						if !value {
+							t.Errorf("%s.%s = false, want true", category, key)
+						}
+					}
+				}
+				if !got["custom"]["beta"] {
+					t.Errorf("custom.beta missing, want keys reported by the server")
+				}
+			},
+		},
+		{
+			name:      "another group",
+			base:      types.StringValue("group-1"),
+			workspace: map[string]bool{"models": true},
+			check: func(t *testing.T, got map[string]map[string]bool) {
+				want := map[string]map[string]bool{
+					"sharing":   {"public_models": true},
+					"workspace": {"models": true},
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("got %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:    "unknown group",
+			base:    types.StringValue("missing"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perms := groupResourcePermissionsModel{
+				Base: tt.base,
+				groupPermissionsModel: groupPermissionsModel{
+					Workspace:  boolMap(t, tt.workspace),
+					Sharing:    types.MapNull(types.BoolType),
+					Chat:       types.MapNull(types.BoolType),
+					Features:   types.MapNull(types.BoolType),
+					Additional: types.MapNull(groupPermissionFlagsType),
+				},
+			}
+
+			var diags diag.Diagnostics
+			got := effectivePermissions(context.Background(), apiClient, &permissionCatalog{}, perms, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if tt.check != nil {
+				tt.check(t, got)
+			}
+		})
+	}
+}
+
+func TestOverridePermissions(t *testing.T) {
+	ctx := context.Background()
+	flags := map[string]map[string]bool{
+		"workspace": {"models": true, "tools": false},
+		"chat":      {"delete": false},
+		"custom":    {"beta": true, "alpha": false},
+	}
+
+	additional := func(values map[string]map[string]bool) types.Map {
+		m, diags := types.MapValueFrom(ctx, groupPermissionFlagsType, values)
+		if diags.HasError() {
+			t.Fatalf("building map: %v", diags)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name  string
+		prior groupPermissionsModel
+		want  groupPermissionsModel
+	}{
+		{
+			name: "unconfigured categories stay null",
+			prior: groupPermissionsModel{
+				Workspace:  types.MapNull(types.BoolType),
+				Sharing:    types.MapNull(types.BoolType),
+				Chat:       types.MapNull(types.BoolType),
+				Features:   types.MapNull(types.BoolType),
+				Additional: types.MapNull(groupPermissionFlagsType),
+			},
+			want: groupPermissionsModel{
+				Workspace:  types.MapNull(types.BoolType),
+				Sharing:    types.MapNull(types.BoolType),
+				Chat:       types.MapNull(types.BoolType),
+				Features:   types.MapNull(types.BoolType),
+				Additional: types.MapNull(groupPermissionFlagsType),
+			},
+		},
+		{
+			name: "only configured keys are kept with the server values",
+			prior: groupPermissionsModel{
+				Workspace:  boolMap(t, map[string]bool{"models": false}),
+				Sharing:    types.MapNull(types.BoolType),
+				Chat:       boolMap(t, map[string]bool{"delete": true}),
+				Features:   types.MapNull(types.BoolType),
+				Additional: additional(map[string]map[string]bool{"custom": {"beta": false}}),
+			},
+			want: groupPermissionsModel{
+				Workspace:  boolMap(t, map[string]bool{"models": true}),
+				Sharing:    types.MapNull(types.BoolType),
+				Chat:       boolMap(t, map[string]bool{"delete": false}),
+				Features:   types.MapNull(types.BoolType),
+				Additional: additional(map[string]map[string]bool{"custom": {"beta": true}}),
+			},
+		},
+		{
+			name: "keys missing from the server are dropped",
+			prior: groupPermissionsModel{
+				Workspace:  boolMap(t, map[string]bool{"models": true, "gone": true}),
+				Sharing:    boolMap(t, map[string]bool{"public_models": true}),
+				Chat:       types.MapNull(types.BoolType),
+				Features:   types.MapNull(types.BoolType),
+				Additional: additional(map[string]map[string]bool{"unknown": {"x": true}}),
+			},
+			want: groupPermissionsModel{
+				Workspace:  boolMap(t, map[string]bool{"models": true}),
+				Sharing:    boolMap(t, map[string]bool{}),
+				Chat:       types.MapNull(types.BoolType),
+				Features:   types.MapNull(types.BoolType),
+				Additional: additional(map[string]map[string]bool{"unknown": {}}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := overridePermissions(ctx, flags, tt.prior, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			pairs := []struct {
+				category  string
+				got, want types.Map
+			}{
+				{"workspace", got.Workspace, tt.want.Workspace},
+				{"sharing", got.Sharing, tt.want.Sharing},
+				{"chat", got.Chat, tt.want.Chat},
+				{"features", got.Features, tt.want.Features},
+				{"additional", got.Additional, tt.want.Additional},
+			}
+			for _, pair := range pairs {
+				if !pair.got.Equal(pair.want) {
+					t.Errorf("%s = %s, want %s", pair.category, pair.got, pair.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Set = nullWhenConfiguredModifier{}
+
+// nullWhenConfiguredModifier plans an unconfigured computed set as null when any of the
+// given attributes is configured, replacing values carried over from prior state.
+type nullWhenConfiguredModifier struct {
+	paths []path.Path
+}
+
+// nullWhenConfigured returns a plan modifier that nulls the set when any of paths is set.
+func nullWhenConfigured(paths ...path.Path) planmodifier.Set {
+	return nullWhenConfiguredModifier{paths: paths}
+}
+
+// Description implements planmodifier.Set.
+func (m nullWhenConfiguredModifier) Description(_ context.Context) string {
+	names := make([]string, 0, len(m.paths))
+	for _, p := range m.paths {
+		names = append(names, p.String())
+	}
+
+	return fmt.Sprintf("value is null when any of %s is configured", strings.Join(names, ", "))
+}
+
+// MarkdownDescription implements planmodifier.Set.
+func (m nullWhenConfiguredModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifySet implements planmodifier.Set.
+func (m nullWhenConfiguredModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, p := range m.paths {
+		var other types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &other)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !other.IsNull() {
+			resp.PlanValue = types.SetNull(req.PlanValue.ElementType(ctx))
+			return
+		}
+	}
+}
+
+var _ planmodifier.Set = nullWhenStringInModifier{}
+
+// nullWhenStringInModifier plans an unconfigured computed set as null when a string
+// attribute is configured with one of the given values.
+type nullWhenStringInModifier struct {
+	attribute path.Path
+	values    []string
+}
+
+// nullWhenStringIn returns a plan modifier that nulls the set when attribute is one of values.
+func nullWhenStringIn(attribute path.Path, values ...string) planmodifier.Set {
+	return nullWhenStringInModifier{attribute: attribute, values: values}
+}
+
+// Description implements planmodifier.Set.
+func (m nullWhenStringInModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("value is null when %s is one of %s", m.attribute, strings.Join(m.values, ", "))
+}
+
+// MarkdownDescription implements planmodifier.Set.
+func (m nullWhenStringInModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifySet implements planmodifier.Set.
+func (m nullWhenStringInModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var other types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, m.attribute, &other)...)
+	if resp.Diagnostics.HasError() || other.IsNull() || other.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(m.values, other.ValueString()) {
+		resp.PlanValue = types.SetNull(req.PlanValue.ElementType(ctx))
+	}
+}
+
+var _ planmodifier.String = visibilityPlanModifier{}
+
+// visibilityPlanModifier plans an unconfigured visibility. Configured access grants make
+// the record restricted; otherwise the prior visibility is kept.
+type visibilityPlanModifier struct{}
+
+// Description implements planmodifier.String.
+func (m visibilityPlanModifier) Description(_ context.Context) string {
+	return "value is restricted when access grants are configured and unchanged otherwise"
+}
+
+// MarkdownDescription implements planmodifier.String.
+func (m visibilityPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements planmodifier.String.
+func (m visibilityPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, grantPath := range accessGrantPaths {
+		var grants types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, grantPath, &grants)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !grants.IsNull() && (grants.IsUnknown() || len(grants.Elements()) > 0) {
+			resp.PlanValue = types.StringValue(visibilityRestricted)
+			return
+		}
+	}
+
+	if !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -2,13 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -16,9 +20,16 @@ import (
 )
 
 var _ provider.Provider = &openWebUIProvider{}
+var _ provider.ProviderWithEphemeralResources = &openWebUIProvider{}
 
 const defaultEndpoint = "http://localhost:3000/api/v1"
 
+// User matching modes used when resolving user identifiers to IDs.
+const (
+	userMatchExact = "exact"
+	userMatchFuzzy = "fuzzy"
+)
+
 // openWebUIProvider defines the provider implementation.
 type openWebUIProvider struct {
 	version string
@@ -26,8 +37,17 @@ type openWebUIProvider struct {
 
 // providerModel maps provider schema data to Go type.
 type providerModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Token    types.String `tfsdk:"token"`
+	Endpoint  types.String `tfsdk:"endpoint"`
+	Token     types.String `tfsdk:"token"`
+	UserMatch types.String `tfsdk:"user_match"`
+}
+
+// providerData is handed to resources, data sources and ephemeral resources.
+type providerData struct {
+	client      *client.Client
+	userMatch   string
+	permissions *permissionCatalog
+	baseModels  *baseModelCatalog
 }
 
 // New instantiates a new provider.
@@ -56,6 +76,13 @@ func (p *openWebUIProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Sensitive:   true,
 				Description: "API token used to authenticate against the Open WebUI API. Can also be supplied via the OPENWEBUI_TOKEN environment variable.",
 			},
+			"user_match": schema.StringAttribute{
+				Optional:    true,
+				Description: "How user identifiers are resolved to user IDs. `fuzzy` (default) falls back to partial matches; `exact` requires a unique email address, username or ID match and fails otherwise. Can also be supplied via the OPENWEBUI_USER_MATCH environment variable and overridden per resource.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(userMatchExact, userMatchFuzzy),
+				},
+			},
 		},
 	}
 }
@@ -84,6 +111,22 @@ func (p *openWebUIProvider) Configure(ctx context.Context, req provider.Configur
 		token = envToken
 	}
 
+	userMatch := userMatchFuzzy
+	if !data.UserMatch.IsNull() && !data.UserMatch.IsUnknown() {
+		userMatch = data.UserMatch.ValueString()
+	} else if envUserMatch := os.Getenv("OPENWEBUI_USER_MATCH"); envUserMatch != "" {
+		userMatch = envUserMatch
+	}
+
+	if userMatch != userMatchExact && userMatch != userMatchFuzzy {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_match"),
+			"Invalid user match mode",
+			fmt.Sprintf("Expected user_match to be %q or %q, got %q.", userMatchExact, userMatchFuzzy, userMatch),
+		)
+		return
+	}
+
 	if token == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
@@ -102,12 +145,35 @@ func (p *openWebUIProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// Groups, users and permissions can only be changed by admins; say so before the
+	// first mutation fails with a bare 401.
+	if identity, err := apiClient.GetSessionUser(ctx); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to verify the Open WebUI token",
+			fmt.Sprintf("Reading the identity behind the token from /auths/ failed: %v", err),
+		)
+	} else if identity.Role != "admin" {
+		resp.Diagnostics.AddWarning(
+			"Open WebUI token is not an admin",
+			fmt.Sprintf("The token belongs to %s with role %q. Creating or changing groups, users and permissions requires an admin token and will fail.", identity.Email, identity.Role),
+		)
+	}
+
 	tflog.Debug(ctx, "Configured Open WebUI provider", map[string]any{
-		"endpoint": endpoint,
+		"endpoint":   endpoint,
+		"user_match": userMatch,
 	})
 
-	resp.ResourceData = apiClient
-	resp.DataSourceData = apiClient
+	shared := &providerData{
+		client:      apiClient,
+		userMatch:   userMatch,
+		permissions: &permissionCatalog{},
+		baseModels:  &baseModelCatalog{},
+	}
+
+	resp.ResourceData = shared
+	resp.DataSourceData = shared
+	resp.EphemeralResourceData = shared
 }
 
 // Resources defines provider-supported resources.
@@ -117,6 +183,13 @@ func (p *openWebUIProvider) Resources(_ context.Context) []func() resource.Resou
 		NewModelResource,
 		NewPromptResource,
 		NewGroupResource,
+		NewAPIKeyResource,
+		NewConfigResource,
+		NewFolderResource,
+		NewMemoryResource,
+		NewChatResource,
+		NewUserSettingsResource,
+		NewGroupMemberResource,
 	}
 }
 
@@ -127,5 +200,27 @@ func (p *openWebUIProvider) DataSources(_ context.Context) []func() datasource.D
 		NewKnowledgeDataSource,
 		NewGroupDataSource,
 		NewPromptDataSource,
+		NewMemoryQueryDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewUserPermissionsDataSource,
+		NewModelsDataSource,
+		NewKnowledgeBasesDataSource,
+		NewGroupsDataSource,
+		NewPromptsDataSource,
+		NewBaseModelsDataSource,
+		NewCurrentUserDataSource,
+		NewServerDataSource,
+		NewToolDataSource,
+		NewToolsDataSource,
+		NewFunctionDataSource,
+		NewFunctionsDataSource,
+	}
+}
+
+// EphemeralResources defines provider-supported ephemeral resources.
+func (p *openWebUIProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSessionTokenEphemeralResource,
 	}
 }
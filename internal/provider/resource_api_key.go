@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &apiKeyResource{}
+var _ resource.ResourceWithConfigure = &apiKeyResource{}
+var _ resource.ResourceWithImportState = &apiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &apiKeyResource{}
+
+// apiKeyResource manages the API key of the identity the provider authenticates as.
+type apiKeyResource struct {
+	client *client.Client
+}
+
+// apiKeyResourceModel maps Terraform state.
+type apiKeyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Email           types.String `tfsdk:"email"`
+	APIKey          types.String `tfsdk:"api_key"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+}
+
+// NewAPIKeyResource constructs a new resource instance.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the resource schema for API keys.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the user that owns the API key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"email": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Email address of the user that owns the API key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"api_key": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Generated API key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, generates a new API key and invalidates the previous one.",
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+// ModifyPlan marks the key as unknown when a rotation is requested.
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_key"), types.StringUnknown())...)
+	}
+}
+
+// Create generates a new API key.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing API keys.")
+		return
+	}
+
+	var plan apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, ok := generateAPIKey(ctx, r.client, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the key from the API.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing API keys.")
+		return
+	}
+
+	var state apiKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetSessionUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read session user failed", err.Error())
+		return
+	}
+
+	key, err := r.client.GetAPIKey(ctx)
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read API key failed", err.Error())
+		return
+	}
+
+	if key.APIKey == nil || *key.APIKey == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(user.ID)
+	state.Email = types.StringValue(user.Email)
+	state.APIKey = types.StringValue(*key.APIKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update rotates the key when rotation_trigger changes.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing API keys.")
+		return
+	}
+
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationTrigger.Equal(state.RotationTrigger) {
+		state.RotationTrigger = plan.RotationTrigger
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	updated, ok := generateAPIKey(ctx, r.client, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Delete revokes the key.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing API keys.")
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(ctx); err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Delete API key failed", err.Error())
+		return
+	}
+}
+
+// ImportState adopts the existing API key; the import identifier is replaced by the user ID on read.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// generateAPIKey requests a new API key and maps it into Terraform state.
+func generateAPIKey(ctx context.Context, apiClient *client.Client, plan apiKeyResourceModel, diags *diag.Diagnostics) (apiKeyResourceModel, bool) {
+	user, err := apiClient.GetSessionUser(ctx)
+	if err != nil {
+		diags.AddError("Read session user failed", err.Error())
+		return plan, false
+	}
+
+	key, err := apiClient.GenerateAPIKey(ctx)
+	if err != nil {
+		diags.AddError("Generate API key failed", err.Error())
+		return plan, false
+	}
+
+	if key.APIKey == nil || *key.APIKey == "" {
+		diags.AddError("Generate API key failed", "Open WebUI returned an empty API key. Ensure API keys are enabled for this instance and user role.")
+		return plan, false
+	}
+
+	return apiKeyResourceModel{
+		ID:              types.StringValue(user.ID),
+		Email:           types.StringValue(user.Email),
+		APIKey:          types.StringValue(*key.APIKey),
+		RotationTrigger: plan.RotationTrigger,
+	}, true
+}
@@ -0,0 +1,433 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &chatResource{}
+var _ resource.ResourceWithConfigure = &chatResource{}
+var _ resource.ResourceWithImportState = &chatResource{}
+var _ resource.ResourceWithModifyPlan = &chatResource{}
+
+// chatResource manages imported chats of the identity the provider authenticates as.
+type chatResource struct {
+	client *client.Client
+}
+
+// chatResourceModel maps Terraform state.
+type chatResourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	ChatJSON  jsontypes.Normalized `tfsdk:"chat_json"`
+	MetaJSON  jsontypes.Normalized `tfsdk:"meta_json"`
+	FolderID  types.String         `tfsdk:"folder_id"`
+	Pinned    types.Bool           `tfsdk:"pinned"`
+	Archived  types.Bool           `tfsdk:"archived"`
+	Shared    types.Bool           `tfsdk:"shared"`
+	ShareID   types.String         `tfsdk:"share_id"`
+	Title     types.String         `tfsdk:"title"`
+	UserID    types.String         `tfsdk:"user_id"`
+	CreatedAt types.String         `tfsdk:"created_at"`
+	UpdatedAt types.String         `tfsdk:"updated_at"`
+}
+
+// NewChatResource constructs a new resource instance.
+func NewChatResource() resource.Resource {
+	return &chatResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *chatResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chat"
+}
+
+// Schema defines the resource schema for chats.
+func (r *chatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Chat identifier.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"chat_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+				Description: "JSON object holding the chat document, including `title`, `models` and the message `history`.",
+			},
+			"meta_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+				Description: "JSON object with chat metadata such as `tags`. Changing it re-imports the chat.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the folder that holds the chat.",
+			},
+			"pinned": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the chat is pinned in the sidebar.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the chat is archived.",
+			},
+			"shared": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether a public snapshot of the chat is shared. The snapshot is refreshed whenever `chat_json` changes.",
+			},
+			"share_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the shared snapshot, used in `/s/<share_id>` links.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": schema.StringAttribute{
+				Computed:    true,
+				Description: "Chat title derived from the chat document.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the user who owns the chat.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Creation date in YYYY-MM-DD format.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date in YYYY-MM-DD format.",
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *chatResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+// ModifyPlan predicts share_id when sharing is switched on or off.
+func (r *chatResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan chatResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareID := plan.ShareID
+	switch {
+	case plan.Shared.IsUnknown():
+		shareID = types.StringUnknown()
+	case !plan.Shared.ValueBool():
+		shareID = types.StringNull()
+	case req.State.Raw.IsNull():
+		shareID = types.StringUnknown()
+	default:
+		var state chatResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !state.Shared.ValueBool() {
+			shareID = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("share_id"), shareID)...)
+}
+
+// Create imports the chat and applies its flags.
+func (r *chatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing chats.")
+		return
+	}
+
+	var plan chatResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chat := decodeOptionalJSON(plan.ChatJSON.StringValue, path.Root("chat_json"), &resp.Diagnostics)
+	meta := decodeOptionalJSON(plan.MetaJSON.StringValue, path.Root("meta_json"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form := client.ChatImportForm{
+		Chat:   chat,
+		Meta:   meta,
+		Pinned: plan.Pinned.ValueBool(),
+	}
+	if form.Chat == nil {
+		form.Chat = map[string]any{}
+	}
+	if !plan.FolderID.IsNull() && plan.FolderID.ValueString() != "" {
+		folderID := plan.FolderID.ValueString()
+		form.FolderID = &folderID
+	}
+
+	created, err := r.client.ImportChat(ctx, form)
+	if err != nil {
+		resp.Diagnostics.AddError("Import chat failed", err.Error())
+		return
+	}
+	if created.ID == "" {
+		resp.Diagnostics.AddError("Import chat failed", "Open WebUI did not return the imported chat.")
+		return
+	}
+
+	// Track the chat before applying flags so a failed follow-up call does not orphan it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), created.ID)...)
+
+	if plan.Archived.ValueBool() && !created.Archived {
+		if err := r.client.ToggleChatArchive(ctx, created.ID); err != nil {
+			resp.Diagnostics.AddError("Archive chat failed", err.Error())
+			return
+		}
+	}
+
+	if plan.Shared.ValueBool() {
+		if _, err := r.client.ShareChat(ctx, created.ID); err != nil {
+			resp.Diagnostics.AddError("Share chat failed", err.Error())
+			return
+		}
+	}
+
+	state, ok := readChatState(ctx, r.client, created.ID, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the chat from the API.
+func (r *chatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing chats.")
+		return
+	}
+
+	var state chatResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chat, err := r.client.GetChat(ctx, state.ID.ValueString())
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read chat failed", err.Error())
+		return
+	}
+
+	updated := chatResponseToModel(*chat, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Update applies content, folder and flag changes in place.
+func (r *chatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing chats.")
+		return
+	}
+
+	var plan, state chatResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	chatChanged, diags := plan.ChatJSON.StringSemanticEquals(ctx, state.ChatJSON)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	chatChanged = !chatChanged
+
+	if chatChanged {
+		chat := decodeOptionalJSON(plan.ChatJSON.StringValue, path.Root("chat_json"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if _, err := r.client.UpdateChat(ctx, id, chat); err != nil {
+			resp.Diagnostics.AddError("Update chat failed", err.Error())
+			return
+		}
+	}
+
+	if !plan.FolderID.Equal(state.FolderID) {
+		var folderID *string
+		if !plan.FolderID.IsNull() && plan.FolderID.ValueString() != "" {
+			value := plan.FolderID.ValueString()
+			folderID = &value
+		}
+		if err := r.client.UpdateChatFolder(ctx, id, folderID); err != nil {
+			resp.Diagnostics.AddError("Move chat failed", err.Error())
+			return
+		}
+	}
+
+	if !plan.Pinned.Equal(state.Pinned) {
+		if err := r.client.ToggleChatPin(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Pin chat failed", err.Error())
+			return
+		}
+	}
+
+	if !plan.Archived.Equal(state.Archived) {
+		if err := r.client.ToggleChatArchive(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Archive chat failed", err.Error())
+			return
+		}
+	}
+
+	switch {
+	case plan.Shared.ValueBool() && (!state.Shared.ValueBool() || chatChanged):
+		if _, err := r.client.ShareChat(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Share chat failed", err.Error())
+			return
+		}
+	case !plan.Shared.ValueBool() && state.Shared.ValueBool():
+		if err := r.client.UnshareChat(ctx, id); err != nil && err != client.ErrNotFound {
+			resp.Diagnostics.AddError("Unshare chat failed", err.Error())
+			return
+		}
+	}
+
+	updated, ok := readChatState(ctx, r.client, id, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Delete removes the chat together with its shared snapshot.
+func (r *chatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing chats.")
+		return
+	}
+
+	var state chatResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Shared.ValueBool() {
+		if err := r.client.UnshareChat(ctx, state.ID.ValueString()); err != nil && err != client.ErrNotFound {
+			resp.Diagnostics.AddError("Unshare chat failed", err.Error())
+			return
+		}
+	}
+
+	if err := r.client.DeleteChat(ctx, state.ID.ValueString()); err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Delete chat failed", err.Error())
+		return
+	}
+}
+
+// ImportState supports terraform import by chat ID.
+func (r *chatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readChatState fetches the chat after a change and maps it against the plan.
+func readChatState(ctx context.Context, apiClient *client.Client, id string, plan chatResourceModel, diags *diag.Diagnostics) (chatResourceModel, bool) {
+	chat, err := apiClient.GetChat(ctx, id)
+	if err != nil {
+		diags.AddError("Read chat failed", err.Error())
+		return plan, false
+	}
+
+	state := chatResponseToModel(*chat, plan, diags)
+	if state.FolderID.IsNull() && plan.FolderID.ValueString() == "" {
+		state.FolderID = plan.FolderID
+	}
+
+	return state, !diags.HasError()
+}
+
+// chatResponseToModel converts an API chat into Terraform state. Only the chat and
+// metadata keys configured in prior are tracked, since Open WebUI adds its own.
+func chatResponseToModel(chat client.ChatResponse, prior chatResourceModel, diags *diag.Diagnostics) chatResourceModel {
+	model := chatResourceModel{
+		ID:        types.StringValue(chat.ID),
+		ChatJSON:  projectNormalizedJSON(chat.Chat, prior.ChatJSON, path.Root("chat_json"), diags),
+		MetaJSON:  jsontypes.NewNormalizedNull(),
+		FolderID:  types.StringNull(),
+		Pinned:    types.BoolValue(chat.Pinned != nil && *chat.Pinned),
+		Archived:  types.BoolValue(chat.Archived),
+		Shared:    types.BoolValue(chat.ShareID != nil && *chat.ShareID != ""),
+		ShareID:   types.StringNull(),
+		Title:     types.StringValue(chat.Title),
+		UserID:    types.StringValue(chat.UserID),
+		CreatedAt: formatDateValue(chat.CreatedAt),
+		UpdatedAt: formatDateValue(chat.UpdatedAt),
+	}
+
+	// Metadata is only tracked when configured, or adopted in full on import.
+	if !prior.MetaJSON.IsNull() || prior.ChatJSON.IsNull() {
+		model.MetaJSON = projectNormalizedJSON(chat.Meta, prior.MetaJSON, path.Root("meta_json"), diags)
+	}
+
+	if chat.FolderID != nil && *chat.FolderID != "" {
+		model.FolderID = types.StringValue(*chat.FolderID)
+	}
+
+	if chat.ShareID != nil && *chat.ShareID != "" {
+		model.ShareID = types.StringValue(*chat.ShareID)
+	}
+
+	return model
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &configResource{}
+var _ resource.ResourceWithConfigure = &configResource{}
+var _ resource.ResourceWithImportState = &configResource{}
+var _ resource.ResourceWithModifyPlan = &configResource{}
+
+const (
+	configResourceID      = "config"
+	configStrategyMerge   = "merge"
+	configStrategyReplace = "replace"
+)
+
+// configResource manages the Open WebUI configuration document through export and import.
+type configResource struct {
+	client *client.Client
+}
+
+// configResourceModel maps Terraform state.
+type configResourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	ConfigJSON   jsontypes.Normalized `tfsdk:"config_json"`
+	Strategy     types.String         `tfsdk:"strategy"`
+	ChangedPaths types.List           `tfsdk:"changed_paths"`
+}
+
+// NewConfigResource constructs a new resource instance.
+func NewConfigResource() resource.Resource {
+	return &configResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *configResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+// Schema defines the resource schema for the configuration document.
+func (r *configResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Terraform resource identifier. Always `config`, as the configuration is a singleton.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"config_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+				Description: "JSON object applied to the Open WebUI configuration.",
+			},
+			"strategy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(configStrategyMerge),
+				Description: "How `config_json` is applied. `merge` (default) deep-merges the document onto the exported configuration so unmentioned keys are kept; `replace` imports the document as the complete configuration.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(configStrategyMerge, configStrategyReplace),
+				},
+			},
+			"changed_paths": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Dotted key paths changed by the most recent apply. During planning this lists the paths the pending import will change.",
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *configResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+// ModifyPlan computes the key paths the import will change.
+func (r *configResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state configResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		equal, diags := plan.ConfigJSON.StringSemanticEquals(ctx, state.ConfigJSON)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if equal && plan.Strategy.Equal(state.Strategy) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changed_paths"), state.ChangedPaths)...)
+			return
+		}
+	}
+
+	if r.client == nil || plan.ConfigJSON.IsUnknown() || plan.Strategy.IsUnknown() {
+		return
+	}
+
+	document := decodeConfigDocument(plan.ConfigJSON, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.ExportConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Export config failed", err.Error())
+		return
+	}
+
+	desired := desiredConfig(current, document, plan.Strategy.ValueString())
+	changed, diags := types.ListValueFrom(ctx, types.StringType, diffJSONPaths(current, desired, ""))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changed_paths"), changed)...)
+}
+
+// Create imports the configuration.
+func (r *configResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing configuration.")
+		return
+	}
+
+	var plan configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, ok := applyConfig(ctx, r.client, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the configured keys from the exported configuration.
+func (r *configResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing configuration.")
+		return
+	}
+
+	var state configResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.ExportConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Export config failed", err.Error())
+		return
+	}
+
+	if state.Strategy.IsNull() || state.Strategy.ValueString() == "" {
+		state.Strategy = types.StringValue(configStrategyMerge)
+	}
+	if state.ChangedPaths.IsNull() || state.ChangedPaths.IsUnknown() {
+		state.ChangedPaths = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	// Only the keys under management are compared for merge, so drift elsewhere in the
+	// configuration does not produce a diff. Imported resources start from the full export.
+	shape := state.ConfigJSON
+	if state.Strategy.ValueString() == configStrategyReplace {
+		shape = jsontypes.NewNormalizedNull()
+	}
+
+	state.ID = types.StringValue(configResourceID)
+	state.ConfigJSON = projectNormalizedJSON(current, shape, path.Root("config_json"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update re-imports the configuration.
+func (r *configResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing configuration.")
+		return
+	}
+
+	var plan configResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, ok := applyConfig(ctx, r.client, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the resource from state. The Open WebUI configuration is left as is,
+// since the server has no notion of an unset configuration.
+func (r *configResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState adopts the current configuration.
+func (r *configResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyConfig exports the current configuration, applies the planned document with the
+// selected strategy and imports the result.
+func applyConfig(ctx context.Context, apiClient *client.Client, plan configResourceModel, diags *diag.Diagnostics) (configResourceModel, bool) {
+	document := decodeConfigDocument(plan.ConfigJSON, diags)
+	if diags.HasError() {
+		return plan, false
+	}
+
+	current, err := apiClient.ExportConfig(ctx)
+	if err != nil {
+		diags.AddError("Export config failed", err.Error())
+		return plan, false
+	}
+
+	desired := desiredConfig(current, document, plan.Strategy.ValueString())
+	if _, err := apiClient.ImportConfig(ctx, desired); err != nil {
+		diags.AddError("Import config failed", err.Error())
+		return plan, false
+	}
+
+	state := plan
+	state.ID = types.StringValue(configResourceID)
+	if plan.ChangedPaths.IsUnknown() || plan.ChangedPaths.IsNull() {
+		changed, listDiags := types.ListValueFrom(ctx, types.StringType, diffJSONPaths(current, desired, ""))
+		diags.Append(listDiags...)
+		state.ChangedPaths = changed
+	}
+
+	return state, !diags.HasError()
+}
+
+// desiredConfig returns the configuration that results from applying document to current.
+func desiredConfig(current, document map[string]any, strategy string) map[string]any {
+	if strategy == configStrategyReplace {
+		return document
+	}
+
+	return mergeJSONObjects(current, document)
+}
+
+// decodeConfigDocument parses config_json, which must be a JSON object.
+func decodeConfigDocument(value jsontypes.Normalized, diags *diag.Diagnostics) map[string]any {
+	var document map[string]any
+	if err := json.Unmarshal([]byte(value.ValueString()), &document); err != nil {
+		diags.AddAttributeError(
+			path.Root("config_json"),
+			"Invalid configuration document",
+			fmt.Sprintf("Expected config_json to contain a JSON object: %v", err),
+		)
+		return nil
+	}
+	if document == nil {
+		diags.AddAttributeError(path.Root("config_json"), "Invalid configuration document", "Expected config_json to contain a JSON object, got null.")
+		return nil
+	}
+
+	return document
+}
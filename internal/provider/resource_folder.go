@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &folderResource{}
+var _ resource.ResourceWithConfigure = &folderResource{}
+var _ resource.ResourceWithImportState = &folderResource{}
+var _ resource.ResourceWithModifyPlan = &folderResource{}
+
+// folderResource manages chat folders.
+type folderResource struct {
+	client *client.Client
+}
+
+// folderResourceModel maps Terraform state.
+type folderResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ParentID   types.String `tfsdk:"parent_id"`
+	IsExpanded types.Bool   `tfsdk:"is_expanded"`
+	UserID     types.String `tfsdk:"user_id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// NewFolderResource constructs a new resource instance.
+func NewFolderResource() resource.Resource {
+	return &folderResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *folderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+// Schema defines the resource schema for folders.
+func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Folder identifier.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Folder name. Names must be unique among siblings.",
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier of the parent folder. Omit to place the folder at the root. Changing it moves the folder in place.",
+			},
+			"is_expanded": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the folder is shown expanded in the sidebar.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the user who owns the folder.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Creation date in YYYY-MM-DD format.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date in YYYY-MM-DD format.",
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+// ModifyPlan rejects parent changes that would place a folder below itself.
+func (r *folderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan folderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ParentID.IsNull() || plan.ParentID.IsUnknown() {
+		return
+	}
+
+	selfID := ""
+	if !req.State.Raw.IsNull() {
+		var state folderResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ParentID.Equal(state.ParentID) {
+			return
+		}
+		selfID = state.ID.ValueString()
+	}
+
+	checkFolderAncestry(ctx, r.client, selfID, plan.ParentID.ValueString(), &resp.Diagnostics)
+}
+
+// Create creates the folder at the root and moves it below its parent.
+func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing folders.")
+		return
+	}
+
+	var plan folderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateFolder(ctx, client.FolderForm{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Create folder failed", err.Error())
+		return
+	}
+	if created.ID == "" {
+		resp.Diagnostics.AddError("Create folder failed", "Open WebUI did not return the identifier of the new folder.")
+		return
+	}
+
+	// Track the folder before the follow-up calls so a failed move does not orphan it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), created.ID)...)
+
+	if !plan.ParentID.IsNull() && plan.ParentID.ValueString() != "" {
+		parentID := plan.ParentID.ValueString()
+		if err := r.client.UpdateFolderParent(ctx, created.ID, &parentID); err != nil {
+			resp.Diagnostics.AddError("Move folder failed", err.Error())
+			return
+		}
+	}
+
+	if plan.IsExpanded.ValueBool() {
+		if err := r.client.UpdateFolderExpanded(ctx, created.ID, true); err != nil {
+			resp.Diagnostics.AddError("Update folder failed", err.Error())
+			return
+		}
+	}
+
+	state, ok := readFolderState(ctx, r.client, created.ID, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the folder from the API.
+func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing folders.")
+		return
+	}
+
+	var state folderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.GetFolder(ctx, state.ID.ValueString())
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read folder failed", err.Error())
+		return
+	}
+
+	updated := folderResponseToModel(*folder)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Update moves, renames and expands the folder as needed.
+func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing folders.")
+		return
+	}
+
+	var plan, state folderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	if !plan.ParentID.Equal(state.ParentID) {
+		var parentID *string
+		if !plan.ParentID.IsNull() && plan.ParentID.ValueString() != "" {
+			value := plan.ParentID.ValueString()
+			parentID = &value
+		}
+		if err := r.client.UpdateFolderParent(ctx, id, parentID); err != nil {
+			resp.Diagnostics.AddError("Move folder failed", err.Error())
+			return
+		}
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		if err := r.client.UpdateFolderName(ctx, id, client.FolderForm{Name: plan.Name.ValueString()}); err != nil {
+			resp.Diagnostics.AddError("Update folder failed", err.Error())
+			return
+		}
+	}
+
+	if !plan.IsExpanded.Equal(state.IsExpanded) {
+		if err := r.client.UpdateFolderExpanded(ctx, id, plan.IsExpanded.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Update folder failed", err.Error())
+			return
+		}
+	}
+
+	updated, ok := readFolderState(ctx, r.client, id, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Delete removes the folder.
+func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing folders.")
+		return
+	}
+
+	var state folderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteFolder(ctx, state.ID.ValueString()); err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Delete folder failed", err.Error())
+		return
+	}
+}
+
+// ImportState supports terraform import by folder ID.
+func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readFolderState fetches the folder after a change, keeping the planned parent_id
+// representation for root folders.
+func readFolderState(ctx context.Context, apiClient *client.Client, id string, plan folderResourceModel, diags *diag.Diagnostics) (folderResourceModel, bool) {
+	folder, err := apiClient.GetFolder(ctx, id)
+	if err != nil {
+		diags.AddError("Read folder failed", err.Error())
+		return plan, false
+	}
+
+	state := folderResponseToModel(*folder)
+	if state.ParentID.IsNull() && plan.ParentID.ValueString() == "" {
+		state.ParentID = plan.ParentID
+	}
+
+	return state, true
+}
+
+// checkFolderAncestry walks up from parentID and reports an error when selfID is one
+// of the ancestors, which would turn the move into a cycle.
+func checkFolderAncestry(ctx context.Context, apiClient *client.Client, selfID, parentID string, diags *diag.Diagnostics) {
+	visited := make(map[string]struct{})
+	current := parentID
+	for current != "" {
+		if selfID != "" && current == selfID {
+			diags.AddAttributeError(
+				path.Root("parent_id"),
+				"Folder cycle detected",
+				fmt.Sprintf("Moving folder %s below %s would make it its own ancestor.", selfID, parentID),
+			)
+			return
+		}
+
+		if _, seen := visited[current]; seen {
+			diags.AddAttributeError(
+				path.Root("parent_id"),
+				"Folder cycle detected",
+				fmt.Sprintf("The ancestors of folder %s already form a cycle at %s.", parentID, current),
+			)
+			return
+		}
+		visited[current] = struct{}{}
+
+		folder, err := apiClient.GetFolder(ctx, current)
+		if err != nil {
+			if err == client.ErrNotFound {
+				diags.AddAttributeError(
+					path.Root("parent_id"),
+					"Parent folder not found",
+					fmt.Sprintf("Folder %s does not exist or is not owned by the provider's identity.", current),
+				)
+				return
+			}
+			diags.AddError("Read folder failed", err.Error())
+			return
+		}
+
+		if folder.ParentID == nil {
+			return
+		}
+		current = *folder.ParentID
+	}
+}
+
+// folderResponseToModel converts an API folder into Terraform state.
+func folderResponseToModel(folder client.FolderModel) folderResourceModel {
+	parentID := types.StringNull()
+	if folder.ParentID != nil && *folder.ParentID != "" {
+		parentID = types.StringValue(*folder.ParentID)
+	}
+
+	return folderResourceModel{
+		ID:         types.StringValue(folder.ID),
+		Name:       types.StringValue(folder.Name),
+		ParentID:   parentID,
+		IsExpanded: types.BoolValue(folder.IsExpanded),
+		UserID:     types.StringValue(folder.UserID),
+		CreatedAt:  formatDateValue(folder.CreatedAt),
+		UpdatedAt:  formatDateValue(folder.UpdatedAt),
+	}
+}
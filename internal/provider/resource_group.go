@@ -6,17 +6,19 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
 )
@@ -24,29 +26,55 @@ import (
 var _ resource.Resource = &groupResource{}
 var _ resource.ResourceWithConfigure = &groupResource{}
 var _ resource.ResourceWithImportState = &groupResource{}
+var _ resource.ResourceWithUpgradeState = &groupResource{}
+var _ resource.ResourceWithModifyPlan = &groupResource{}
 
 // groupResource manages Open WebUI groups.
 type groupResource struct {
-	client *client.Client
+	client      *client.Client
+	userMatch   string
+	permissions *permissionCatalog
 }
 
+// Membership modes of the group resource.
+const (
+	groupMembershipAuthoritative = "authoritative"
+	groupMembershipAdditive      = "additive"
+)
+
 // groupResourceModel maps Terraform state.
 type groupResourceModel struct {
-	ID          types.String          `tfsdk:"id"`
-	Name        types.String          `tfsdk:"name"`
-	Description types.String          `tfsdk:"description"`
-	Users       types.List            `tfsdk:"users"`
-	Permissions groupPermissionsModel `tfsdk:"permissions"`
-	UserID      types.String          `tfsdk:"user_id"`
-	CreatedAt   types.String          `tfsdk:"created_at"`
-	UpdatedAt   types.String          `tfsdk:"updated_at"`
+	groupEntryModel
+	Permissions          groupResourcePermissionsModel `tfsdk:"permissions"`
+	EffectivePermissions types.Map                     `tfsdk:"effective_permissions"`
+	MembershipMode       types.String                  `tfsdk:"membership_mode"`
+	UserMatch            types.String                  `tfsdk:"user_match"`
+}
+
+// groupEntryModel holds the attributes shared by the group resource and data source.
+type groupEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Users       types.Set    `tfsdk:"users"`
+	Admins      types.Set    `tfsdk:"admins"`
+	UserID      types.String `tfsdk:"user_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
 }
 
 type groupPermissionsModel struct {
-	Workspace types.Map `tfsdk:"workspace"`
-	Sharing   types.Map `tfsdk:"sharing"`
-	Chat      types.Map `tfsdk:"chat"`
-	Features  types.Map `tfsdk:"features"`
+	Workspace  types.Map `tfsdk:"workspace"`
+	Sharing    types.Map `tfsdk:"sharing"`
+	Chat       types.Map `tfsdk:"chat"`
+	Features   types.Map `tfsdk:"features"`
+	Additional types.Map `tfsdk:"additional"`
+}
+
+// groupResourcePermissionsModel adds the preset the configured permissions override.
+type groupResourcePermissionsModel struct {
+	Base types.String `tfsdk:"base"`
+	groupPermissionsModel
 }
 
 // NewGroupResource constructs a new resource instance.
@@ -62,6 +90,7 @@ func (r *groupResource) Metadata(_ context.Context, req resource.MetadataRequest
 // Schema defines the resource schema for groups.
 func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:      true,
@@ -76,25 +105,50 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "Group description.",
 			},
-			"users": schema.ListAttribute{
+			"users": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
 				Description: "Usernames or email addresses resolved to user IDs when managing group membership.",
 			},
+			"admins": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Usernames or email addresses of group administrators. Admins are added as members when `users` is omitted or `membership_mode` is `additive`; otherwise they must also be listed in `users`.",
+			},
+			"membership_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(groupMembershipAuthoritative),
+				Description: "How `users` is applied. `authoritative` (default) removes members that are not listed; `additive` only adds the listed users and removes them again when they are dropped from the list, leaving other members untouched.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(groupMembershipAuthoritative, groupMembershipAdditive),
+				},
+			},
+			"user_match": schema.StringAttribute{
+				Optional:    true,
+				Description: "How `users` and `admins` are resolved to user IDs, overriding the provider's `user_match`. `exact` requires a unique email address, username or ID match; `fuzzy` falls back to partial matches.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(userMatchExact, userMatchFuzzy),
+				},
+			},
 			"permissions": schema.SingleNestedAttribute{
 				Optional:    true,
 				Computed:    true,
 				Description: "Fine-grained permission flags organised by category.",
 				Attributes: map[string]schema.Attribute{
+					"base": schema.StringAttribute{
+						Optional:    true,
+						Description: "Permissions the category maps override: `defaults` (the default user permissions), `none` (every permission disabled), `all` (every permission enabled) or the ID of another group. When set, categories that are not configured are taken from the base.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
 					"workspace": schema.MapAttribute{
 						Optional:      true,
 						Computed:      true,
 						ElementType:   types.BoolType,
 						Description:   "Workspace-level permissions.",
 						PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
-						Validators: []validator.Map{
-							mapvalidator.KeysAre(stringvalidator.OneOf(groupPermissionsWorkspaceKeys...)),
-						},
 					},
 					"sharing": schema.MapAttribute{
 						Optional:      true,
@@ -102,9 +156,6 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						ElementType:   types.BoolType,
 						Description:   "Sharing permissions.",
 						PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
-						Validators: []validator.Map{
-							mapvalidator.KeysAre(stringvalidator.OneOf(groupPermissionsSharingKeys...)),
-						},
 					},
 					"chat": schema.MapAttribute{
 						Optional:      true,
@@ -112,9 +163,6 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						ElementType:   types.BoolType,
 						Description:   "Chat-related permissions.",
 						PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
-						Validators: []validator.Map{
-							mapvalidator.KeysAre(stringvalidator.OneOf(groupPermissionsChatKeys...)),
-						},
 					},
 					"features": schema.MapAttribute{
 						Optional:      true,
@@ -122,12 +170,21 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						ElementType:   types.BoolType,
 						Description:   "Feature toggle permissions.",
 						PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
-						Validators: []validator.Map{
-							mapvalidator.KeysAre(stringvalidator.OneOf(groupPermissionsFeaturesKeys...)),
-						},
+					},
+					"additional": schema.MapAttribute{
+						Optional:      true,
+						Computed:      true,
+						ElementType:   groupPermissionFlagsType,
+						Description:   "Permission categories without a dedicated attribute, keyed by category name. Use it for categories added by newer Open WebUI releases.",
+						PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
 					},
 				},
 			},
+			"effective_permissions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: groupPermissionFlagsType,
+				Description: "Fully resolved permissions sent to Open WebUI, keyed by category. With `permissions.base` set, this is the base merged with the configured overrides.",
+			},
 			"user_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the user who owns the group.",
@@ -152,9 +209,191 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.userMatch = data.userMatch
+		r.permissions = data.permissions
+	}
+}
+
+// userMatchFor returns the user match mode of the resource, falling back to the provider's.
+func (r *groupResource) userMatchFor(model groupResourceModel) string {
+	if !model.UserMatch.IsNull() && !model.UserMatch.IsUnknown() && model.UserMatch.ValueString() != "" {
+		return model.UserMatch.ValueString()
+	}
+	if r.userMatch != "" {
+		return r.userMatch
+	}
+
+	return userMatchFuzzy
+}
+
+// warnAddedAdmins reports the user each newly listed admin resolves to, so that granting
+// group administration to the wrong account is visible in the plan in every match mode.
+func (r *groupResource) warnAddedAdmins(ctx context.Context, prior, plan groupResourceModel, diags *diag.Diagnostics) {
+	if plan.Admins.IsNull() || plan.Admins.IsUnknown() || plan.Admins.Equal(prior.Admins) {
+		return
+	}
+
+	previous := sliceToSet(expandStringSet(ctx, prior.Admins, path.Root("admins"), diags))
+	match := r.userMatchFor(plan)
+
+	var lines []string
+	for _, admin := range expandStringSet(ctx, plan.Admins, path.Root("admins"), diags) {
+		if _, ok := previous[admin]; ok {
+			continue
+		}
+		// Failures are reported by validateAdminsAreMembers and again at apply time.
+		user, err := lookupUserID(ctx, r.client, admin, match)
+		if err != nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%q -> %s", admin, describeUser(user)))
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	sort.Strings(lines)
+	diags.AddAttributeWarning(
+		path.Root("admins"),
+		"Group admins resolved",
+		fmt.Sprintf("The following admins will be granted group administration (user_match = %q):\n%s", match, strings.Join(lines, "\n")),
+	)
+}
+
+// validateAdminsAreMembers ensures admins are members when users is authoritative.
+// Both sets are compared as resolved user IDs, so an admin may be listed by username
+// in admins and by email address in users.
+func (r *groupResource) validateAdminsAreMembers(ctx context.Context, plan groupResourceModel, diags *diag.Diagnostics) {
+	if plan.MembershipMode.ValueString() == groupMembershipAdditive || plan.MembershipMode.IsUnknown() {
+		return
+	}
+	if plan.Users.IsNull() || plan.Users.IsUnknown() || plan.Admins.IsNull() || plan.Admins.IsUnknown() {
+		return
+	}
+
+	match := r.userMatchFor(plan)
+	users := expandStringSet(ctx, plan.Users, path.Root("users"), diags)
+	admins := expandStringSet(ctx, plan.Admins, path.Root("admins"), diags)
+
+	// Resolution is repeated at apply time, which reports fuzzy-match warnings once;
+	// only failures are surfaced here.
+	var resolveDiags diag.Diagnostics
+	memberIDs := resolveUsernamesToIDs(ctx, r.client, users, match, path.Root("users"), &resolveDiags)
+	diags.Append(resolveDiags.Errors()...)
+	if resolveDiags.HasError() {
+		return
+	}
+
+	members := sliceToSet(memberIDs)
+	for _, admin := range admins {
+		var adminDiags diag.Diagnostics
+		ids := resolveUsernamesToIDs(ctx, r.client, []string{admin}, match, path.Root("admins"), &adminDiags)
+		diags.Append(adminDiags.Errors()...)
+		if len(ids) == 0 {
+			continue
+		}
+		if _, ok := members[ids[0]]; !ok {
+			diags.AddAttributeError(
+				path.Root("admins"),
+				"Group admin is not a member",
+				fmt.Sprintf("%q is listed in admins but not in users. Add it to users, or set membership_mode to \"additive\" to add admins as members automatically.", admin),
+			)
+		}
+	}
+}
+
+// ModifyPlan checks that admins are members, reports the users new admins resolve to,
+// validates configured permission keys and plans effective_permissions. A permissions
+// base is resolved on every plan, so changes to it show up as a diff.
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan groupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateAdminsAreMembers(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior groupResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.warnAddedAdmins(ctx, prior, plan, &resp.Diagnostics)
+
+	var configured types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("permissions"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perms, ok := permissionsObjectModel(ctx, configured, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	validatePermissionKeys(ctx, r.client, r.permissions, perms.groupPermissionsModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if perms.Base.IsNull() {
+		// Without a base, the planned category maps are sent as they are.
+		var planned types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &planned)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		perms, ok = permissionsObjectModel(ctx, planned, &resp.Diagnostics)
+		if !ok {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_permissions"), types.MapUnknown(groupPermissionFlagsType))...)
+			return
+		}
+	} else {
+		// Overrides of a base are exactly the configured maps, so categories removed
+		// from the configuration fall back to the base instead of keeping prior values.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), configured)...)
+	}
+
+	if !permissionsKnown(perms) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_permissions"), types.MapUnknown(groupPermissionFlagsType))...)
+		return
+	}
+
+	effective, diags := flattenPermissionFlags(ctx, effectivePermissions(ctx, r.client, r.permissions, perms, &resp.Diagnostics))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_permissions"), effective)...)
+}
+
+// permissionsDocument returns the permissions sent to Open WebUI. With a base, the
+// permissions resolved at plan time are used so the apply matches the plan.
+func (r *groupResource) permissionsDocument(ctx context.Context, plan groupResourceModel, diags *diag.Diagnostics) map[string]any {
+	if plan.Permissions.Base.IsNull() {
+		return expandPermissions(ctx, plan.Permissions.groupPermissionsModel, diags)
+	}
+
+	if mapProvided(plan.EffectivePermissions) {
+		var flags map[string]map[string]bool
+		diags.Append(plan.EffectivePermissions.ElementsAs(ctx, &flags, false)...)
+		return permissionFlagsDocument(flags)
+	}
+
+	return permissionFlagsDocument(effectivePermissions(ctx, r.client, r.permissions, plan.Permissions, diags))
 }
 
 // Create provisions a group.
@@ -187,25 +426,35 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	providedUsers := !plan.Users.IsNull() && !plan.Users.IsUnknown()
-	providedPermissions := permissionsSpecified(plan.Permissions)
+	providedPermissions := permissionsSpecified(plan.Permissions.groupPermissionsModel) || !plan.Permissions.Base.IsNull()
 	providedMeta := false
 	providedData := false
 
-	usernames := expandStringList(ctx, plan.Users, path.Root("users"), &resp.Diagnostics)
-	resolvedUserIDs := uniqueStrings(resolveUsernamesToIDs(ctx, r.client, usernames, path.Root("users"), &resp.Diagnostics))
+	match := r.userMatchFor(plan)
+	usernames := expandStringSet(ctx, plan.Users, path.Root("users"), &resp.Diagnostics)
+	resolvedUserIDs := uniqueStrings(resolveUsernamesToIDs(ctx, r.client, usernames, match, path.Root("users"), &resp.Diagnostics))
+
+	providedAdmins := !plan.Admins.IsNull() && !plan.Admins.IsUnknown()
+	adminNames := expandStringSet(ctx, plan.Admins, path.Root("admins"), &resp.Diagnostics)
+	adminIDs := uniqueStrings(resolveUsernamesToIDs(ctx, r.client, adminNames, match, path.Root("admins"), &resp.Diagnostics))
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if providedUsers {
+	if providedAdmins {
+		resolvedUserIDs = uniqueStrings(append(resolvedUserIDs, adminIDs...))
+		updateForm.AdminIDs = append([]string{}, adminIDs...)
+	}
+
+	if providedUsers || providedAdmins {
 		if err := r.client.AddGroupUsers(ctx, created.ID, resolvedUserIDs); err != nil {
 			resp.Diagnostics.AddError("Add group members failed", err.Error())
 			return
 		}
 	}
 
-	updateForm.Permissions = expandPermissions(ctx, plan.Permissions, &resp.Diagnostics)
+	updateForm.Permissions = r.permissionsDocument(ctx, plan, &resp.Diagnostics)
 	updateForm.Meta = nil
 	updateForm.Data = nil
 
@@ -213,7 +462,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if providedPermissions || providedMeta || providedData {
+	if providedPermissions || providedMeta || providedData || providedAdmins {
 		if _, err := r.client.UpdateGroup(ctx, created.ID, updateForm); err != nil {
 			resp.Diagnostics.AddError("Update group failed", err.Error())
 			return
@@ -226,7 +475,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	state, diags := groupResponseToModel(ctx, r.client, current)
+	state, diags := groupResourceState(ctx, r.client, current, plan, r.userMatchFor(plan))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -258,7 +507,7 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	updated, diags := groupResponseToModel(ctx, r.client, current)
+	updated, diags := groupResourceState(ctx, r.client, current, state, r.userMatchFor(state))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -274,8 +523,9 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var plan groupResourceModel
+	var plan, prior groupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -291,17 +541,33 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Description: plan.Description.ValueString(),
 	}
 
-	usernames := expandStringList(ctx, plan.Users, path.Root("users"), &resp.Diagnostics)
-	desiredIDs := uniqueStrings(resolveUsernamesToIDs(ctx, r.client, usernames, path.Root("users"), &resp.Diagnostics))
-	form.Permissions = expandPermissions(ctx, plan.Permissions, &resp.Diagnostics)
+	match := r.userMatchFor(plan)
+	usernames := expandStringSet(ctx, plan.Users, path.Root("users"), &resp.Diagnostics)
+	desiredIDs := uniqueStrings(resolveUsernamesToIDs(ctx, r.client, usernames, match, path.Root("users"), &resp.Diagnostics))
+	form.Permissions = r.permissionsDocument(ctx, plan, &resp.Diagnostics)
 	form.Meta = nil
 	form.Data = nil
+	form.AdminIDs = desiredGroupAdminIDs(ctx, r.client, plan, prior, current.AdminIDs, match, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Admins must be members, so they are always kept in the desired member set.
+	desiredIDs = uniqueStrings(append(desiredIDs, form.AdminIDs...))
+
 	toAdd, toRemove := diffStringSets(current.UserIDs, desiredIDs)
+	if plan.MembershipMode.ValueString() == groupMembershipAdditive {
+		// Only users that this resource previously listed are removed.
+		priorUsernames := expandStringSet(ctx, prior.Users, path.Root("users"), &resp.Diagnostics)
+		priorIDs := resolveUsernamesToIDs(ctx, r.client, priorUsernames, match, path.Root("users"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, dropped := diffStringSets(priorIDs, desiredIDs)
+		toRemove = intersectStrings(dropped, current.UserIDs)
+	}
 
 	if err := r.client.RemoveGroupUsers(ctx, plan.ID.ValueString(), toRemove); err != nil {
 		resp.Diagnostics.AddError("Remove group members failed", err.Error())
@@ -324,7 +590,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	state, diags := groupResponseToModel(ctx, r.client, fresh)
+	state, diags := groupResourceState(ctx, r.client, fresh, plan, r.userMatchFor(plan))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -356,30 +622,42 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// UpgradeState converts state written while the string collections were lists.
+func (r *groupResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeListsToSets("users", "admins"),
+	}
+}
+
 // ImportState passes the import identifier through to the id attribute.
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// An empty users set makes the following Read list every member by email.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), types.SetValueMust(types.StringType, []attr.Value{}))...)
 }
 
 // groupResponseToModel converts API structures to Terraform state.
-func groupResponseToModel(ctx context.Context, apiClient *client.Client, resp *client.GroupResponse) (groupResourceModel, diag.Diagnostics) {
+func groupResponseToModel(ctx context.Context, apiClient *client.Client, resp *client.GroupResponse) (groupEntryModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	permissions, permDiags := flattenPermissions(ctx, resp.Permissions)
-	diags.Append(permDiags...)
-
 	usernames, nameDiags := fetchUsernamesForIDs(ctx, apiClient, resp.UserIDs)
 	diags.Append(nameDiags...)
 
-	usersList, usersDiags := types.ListValueFrom(ctx, types.StringType, usernames)
+	usersList, usersDiags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(usernames))
 	diags.Append(usersDiags...)
 
-	model := groupResourceModel{
+	adminNames, adminDiags := fetchUsernamesForIDs(ctx, apiClient, resp.AdminIDs)
+	diags.Append(adminDiags...)
+
+	adminsList, adminsListDiags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(adminNames))
+	diags.Append(adminsListDiags...)
+
+	model := groupEntryModel{
 		ID:          types.StringValue(resp.ID),
 		Name:        types.StringValue(resp.Name),
 		Description: types.StringValue(resp.Description),
 		Users:       usersList,
-		Permissions: permissions,
+		Admins:      adminsList,
 		UserID:      types.StringValue(resp.UserID),
 		CreatedAt:   formatDateValue(resp.CreatedAt),
 		UpdatedAt:   formatDateValue(resp.UpdatedAt),
@@ -388,66 +666,256 @@ func groupResponseToModel(ctx context.Context, apiClient *client.Client, resp *c
 	return model, diags
 }
 
-func resolveUsernamesToIDs(ctx context.Context, apiClient *client.Client, identifiers []string, attribute path.Path, diags *diag.Diagnostics) []string {
+// groupResourceState converts API structures to resource state. In additive mode only
+// the users listed in prior that are still members are reported.
+func groupResourceState(ctx context.Context, apiClient *client.Client, resp *client.GroupResponse, prior groupResourceModel, match string) (groupResourceModel, diag.Diagnostics) {
+	entry, diags := groupResponseToModel(ctx, apiClient, resp)
+
+	flags := permissionFlags(ctx, resp.Permissions)
+	effective, effectiveDiags := flattenPermissionFlags(ctx, flags)
+	diags.Append(effectiveDiags...)
+
+	state := groupResourceModel{
+		groupEntryModel:      entry,
+		EffectivePermissions: effective,
+		MembershipMode:       prior.MembershipMode,
+		UserMatch:            prior.UserMatch,
+	}
+	state.Permissions.Base = prior.Permissions.Base
+	if prior.Permissions.Base.IsNull() {
+		permissions, permDiags := flattenPermissions(ctx, resp.Permissions)
+		diags.Append(permDiags...)
+		state.Permissions.groupPermissionsModel = permissions
+	} else {
+		state.Permissions.groupPermissionsModel = overridePermissions(ctx, flags, prior.Permissions.groupPermissionsModel, &diags)
+	}
+	if state.MembershipMode.IsNull() || state.MembershipMode.IsUnknown() {
+		state.MembershipMode = types.StringValue(groupMembershipAuthoritative)
+	}
+
+	// Label members with the identifiers from the configuration so usernames are not
+	// read back as email addresses. Authoritative mode also reports unlisted members
+	// so that drift shows up in the plan.
+	authoritative := state.MembershipMode.ValueString() != groupMembershipAdditive
+	state.Users = managedGroupUsers(ctx, apiClient, prior.Users, resp.UserIDs, match, authoritative, path.Root("users"), &diags)
+	state.Admins = managedGroupUsers(ctx, apiClient, prior.Admins, resp.AdminIDs, match, authoritative, path.Root("admins"), &diags)
+
+	return state, diags
+}
+
+// desiredGroupAdminIDs computes the admin_ids to send. It returns nil when admins are
+// not managed, which leaves the group's administrators unchanged.
+func desiredGroupAdminIDs(ctx context.Context, apiClient *client.Client, plan, prior groupResourceModel, currentIDs []string, match string, diags *diag.Diagnostics) []string {
+	if plan.Admins.IsNull() && prior.Admins.IsNull() {
+		return nil
+	}
+
+	names := expandStringSet(ctx, plan.Admins, path.Root("admins"), diags)
+	desired := uniqueStrings(resolveUsernamesToIDs(ctx, apiClient, names, match, path.Root("admins"), diags))
+
+	if plan.MembershipMode.ValueString() == groupMembershipAdditive {
+		priorNames := expandStringSet(ctx, prior.Admins, path.Root("admins"), diags)
+		priorIDs := resolveUsernamesToIDs(ctx, apiClient, priorNames, match, path.Root("admins"), diags)
+		_, dropped := diffStringSets(priorIDs, desired)
+		kept, _ := diffStringSets(dropped, currentIDs)
+		desired = uniqueStrings(append(kept, desired...))
+	}
+
+	return append([]string{}, desired...)
+}
+
+// managedGroupUsers keeps the configured identifiers that still resolve to a member. When
+// unlisted is set, members that no identifier resolves to are added by email. It returns
+// null when the attribute is not configured.
+func managedGroupUsers(ctx context.Context, apiClient *client.Client, configured types.Set, memberIDs []string, match string, unlisted bool, attribute path.Path, diags *diag.Diagnostics) types.Set {
+	if configured.IsNull() || configured.IsUnknown() {
+		return types.SetNull(types.StringType)
+	}
+
+	members := make(map[string]struct{}, len(memberIDs))
+	for _, id := range memberIDs {
+		members[id] = struct{}{}
+	}
+
+	identifiers := expandStringSet(ctx, configured, attribute, diags)
+	kept := make([]string, 0, len(identifiers))
+	labelled := make(map[string]struct{}, len(identifiers))
+	for _, identifier := range identifiers {
+		user, err := lookupUserID(ctx, apiClient, identifier, match)
+		if err != nil {
+			continue
+		}
+		if _, ok := members[user.ID]; ok {
+			kept = append(kept, identifier)
+			labelled[user.ID] = struct{}{}
+		}
+	}
+
+	if unlisted {
+		var others []string
+		for _, id := range memberIDs {
+			if _, ok := labelled[id]; !ok {
+				others = append(others, id)
+			}
+		}
+		labels, labelDiags := fetchUsernamesForIDs(ctx, apiClient, others)
+		diags.Append(labelDiags...)
+		kept = append(kept, labels...)
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(kept))
+	diags.Append(setDiags...)
+	return set
+}
+
+// resolveUserIDs accepts user IDs directly and resolves other identifiers by email
+// address or username with the given match mode.
+func resolveUserIDs(ctx context.Context, apiClient *client.Client, identifiers []string, match string, attribute path.Path, diags *diag.Diagnostics) []string {
+	var ids []string
+	for _, identifier := range identifiers {
+		if user, err := apiClient.GetUser(ctx, identifier); err == nil && user.ID == identifier {
+			ids = append(ids, user.ID)
+			continue
+		}
+
+		ids = append(ids, resolveUsernamesToIDs(ctx, apiClient, []string{identifier}, match, attribute, diags)...)
+	}
+
+	return ids
+}
+
+// resolveUsernamesToIDs maps identifiers to user IDs using the given match mode. Fuzzy
+// matches that do not equal the user's email, username, name or ID are reported as
+// warnings so the resolved user can be checked.
+func resolveUsernamesToIDs(ctx context.Context, apiClient *client.Client, identifiers []string, match string, attribute path.Path, diags *diag.Diagnostics) []string {
 	if len(identifiers) == 0 {
 		return nil
 	}
 
 	var ids []string
 	for _, identifier := range identifiers {
-		id, err := lookupUserID(ctx, apiClient, identifier)
+		user, err := lookupUserID(ctx, apiClient, identifier, match)
 		if err != nil {
 			diags.AddAttributeError(
 				attribute,
 				"Unable to resolve user identifier",
-				fmt.Sprintf("Failed to map %q to an Open WebUI user ID: %v", identifier, err),
+				fmt.Sprintf("Failed to map %q to an Open WebUI user ID (user_match = %q): %v", identifier, match, err),
 			)
 			continue
 		}
-		ids = append(ids, id)
+
+		tflog.Debug(ctx, "Resolved user identifier", map[string]any{
+			"identifier": identifier,
+			"user_id":    user.ID,
+			"user_match": match,
+		})
+		if !userMatchesExactly(user, identifier) && !strings.EqualFold(user.Name, identifier) {
+			diags.AddAttributeWarning(
+				attribute,
+				"User identifier resolved by partial match",
+				fmt.Sprintf("%q resolved to %s. Set user_match = %q to require exact matches.", identifier, describeUser(user), userMatchExact),
+			)
+		}
+		ids = append(ids, user.ID)
 	}
 
 	return ids
 }
 
-func lookupUserID(ctx context.Context, apiClient *client.Client, identifier string) (string, error) {
+// lookupUserID resolves an identifier to a user. In exact mode the identifier must equal
+// the email address, username or ID of exactly one user; fuzzy mode prefers such matches
+// but falls back to names and partial matches.
+func lookupUserID(ctx context.Context, apiClient *client.Client, identifier, match string) (client.User, error) {
 	users, err := apiClient.SearchUsers(ctx, identifier, 50)
 	if err != nil {
-		return "", err
+		return client.User{}, err
+	}
+
+	if match == userMatchExact {
+		return lookupUserExact(ctx, apiClient, identifier, users)
 	}
 
 	if len(users) == 0 {
-		return "", fmt.Errorf("no matching users found")
+		return client.User{}, fmt.Errorf("no matching users found")
 	}
 
 	// Prefer exact matches on email, username, or name.
 	for _, u := range users {
-		if strings.EqualFold(u.Email, identifier) {
-			return u.ID, nil
-		}
-		if u.Username != nil && strings.EqualFold(*u.Username, identifier) {
-			return u.ID, nil
-		}
-		if strings.EqualFold(u.Name, identifier) {
-			return u.ID, nil
+		if userMatchesExactly(u, identifier) || strings.EqualFold(u.Name, identifier) {
+			return u, nil
 		}
 	}
 
 	if len(users) == 1 {
-		return users[0].ID, nil
+		return users[0], nil
 	}
 
 	normalized := strings.ToLower(identifier)
 	for _, u := range users {
 		if strings.Contains(strings.ToLower(u.Email), normalized) {
-			return u.ID, nil
+			return u, nil
 		}
 		if u.Username != nil && strings.Contains(strings.ToLower(*u.Username), normalized) {
-			return u.ID, nil
+			return u, nil
+		}
+	}
+
+	return users[0], nil
+}
+
+// lookupUserExact picks the single search result whose email, username or ID equals the
+// identifier. IDs are not matched by the search endpoint, so they are fetched directly.
+func lookupUserExact(ctx context.Context, apiClient *client.Client, identifier string, users []client.User) (client.User, error) {
+	var matches []client.User
+	for _, u := range users {
+		if userMatchesExactly(u, identifier) {
+			matches = append(matches, u)
 		}
 	}
 
-	return users[0].ID, nil
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		if user, err := apiClient.GetUser(ctx, identifier); err == nil && user.ID == identifier {
+			return *user, nil
+		}
+		if len(users) == 0 {
+			return client.User{}, fmt.Errorf("no user has this email address, username or ID")
+		}
+		return client.User{}, fmt.Errorf("no user has this email address, username or ID; similar users: %s", describeUsers(users))
+	default:
+		return client.User{}, fmt.Errorf("%d users match: %s", len(matches), describeUsers(matches))
+	}
+}
+
+// userMatchesExactly reports whether identifier equals the user's email, username or ID.
+func userMatchesExactly(user client.User, identifier string) bool {
+	if strings.EqualFold(user.Email, identifier) || user.ID == identifier {
+		return true
+	}
+
+	return user.Username != nil && strings.EqualFold(*user.Username, identifier)
+}
+
+// describeUser renders a user for diagnostics as "email (username) [id]".
+func describeUser(user client.User) string {
+	label := user.Email
+	if user.Username != nil && *user.Username != "" {
+		label = fmt.Sprintf("%s (%s)", label, *user.Username)
+	}
+
+	return fmt.Sprintf("%s [%s]", label, user.ID)
+}
+
+// describeUsers renders a list of users for diagnostics.
+func describeUsers(users []client.User) string {
+	labels := make([]string, 0, len(users))
+	for _, u := range users {
+		labels = append(labels, describeUser(u))
+	}
+
+	return strings.Join(labels, ", ")
 }
 
 func fetchUsernamesForIDs(ctx context.Context, apiClient *client.Client, ids []string) ([]string, diag.Diagnostics) {
@@ -507,6 +975,22 @@ func uniqueStrings(values []string) []string {
 	return result
 }
 
+func intersectStrings(values, other []string) []string {
+	keep := make(map[string]struct{}, len(other))
+	for _, v := range other {
+		keep[v] = struct{}{}
+	}
+
+	var result []string
+	for _, v := range values {
+		if _, ok := keep[v]; ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 func diffStringSets(current, desired []string) (toAdd, toRemove []string) {
 	currentSet := make(map[string]struct{}, len(current))
 	desiredSet := make(map[string]struct{}, len(desired))
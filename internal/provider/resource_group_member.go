@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &groupMemberResource{}
+var _ resource.ResourceWithConfigure = &groupMemberResource{}
+var _ resource.ResourceWithImportState = &groupMemberResource{}
+var _ resource.ResourceWithModifyPlan = &groupMemberResource{}
+
+// groupMemberResource manages a single group membership without owning the group.
+type groupMemberResource struct {
+	client    *client.Client
+	userMatch string
+}
+
+// groupMemberResourceModel maps Terraform state.
+type groupMemberResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	GroupID   types.String `tfsdk:"group_id"`
+	User      types.String `tfsdk:"user"`
+	UserID    types.String `tfsdk:"user_id"`
+	UserMatch types.String `tfsdk:"user_match"`
+}
+
+// NewGroupMemberResource constructs a new resource instance.
+func NewGroupMemberResource() resource.Resource {
+	return &groupMemberResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *groupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member"
+}
+
+// Schema defines the resource schema for group memberships.
+func (r *groupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Membership identifier in the form `<group_id>/<user_id>`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "Identifier of the group.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"user": schema.StringAttribute{
+				Required:      true,
+				Description:   "Email address, username or ID of the member. Changing it to another identifier of the same user updates it in place.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Resolved identifier of the member.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_match": schema.StringAttribute{
+				Optional:    true,
+				Description: "How `user` is resolved to a user ID, overriding the provider's `user_match`. `exact` requires a unique email address, username or ID match; `fuzzy` falls back to partial matches.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(userMatchExact, userMatchFuzzy),
+				},
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *groupMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.userMatch = data.userMatch
+	}
+}
+
+// userMatchFor returns the match mode configured on the membership or the provider default.
+func (r *groupMemberResource) userMatchFor(model groupMemberResourceModel) string {
+	if !model.UserMatch.IsNull() && model.UserMatch.ValueString() != "" {
+		return model.UserMatch.ValueString()
+	}
+
+	return r.userMatch
+}
+
+// ModifyPlan keeps the membership when user changes to another identifier of the same
+// user, such as the email address an import records being replaced by a username.
+func (r *groupMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state groupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.User.IsUnknown() || plan.User.Equal(state.User) || !plan.GroupID.Equal(state.GroupID) {
+		return
+	}
+
+	// A user that cannot be resolved is replaced, and Create reports the error.
+	var resolveDiags diag.Diagnostics
+	userIDs := resolveUserIDs(ctx, r.client, []string{plan.User.ValueString()}, r.userMatchFor(plan), path.Root("user"), &resolveDiags)
+	if resolveDiags.HasError() || len(userIDs) == 0 || userIDs[0] != state.UserID.ValueString() {
+		return
+	}
+
+	requiresReplace := make(path.Paths, 0, len(resp.RequiresReplace))
+	for _, p := range resp.RequiresReplace {
+		if !p.Equal(path.Root("user")) {
+			requiresReplace = append(requiresReplace, p)
+		}
+	}
+	resp.RequiresReplace = requiresReplace
+}
+
+// Create adds the user to the group.
+func (r *groupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing group members.")
+		return
+	}
+
+	var plan groupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs := resolveUserIDs(ctx, r.client, []string{plan.User.ValueString()}, r.userMatchFor(plan), path.Root("user"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	userID := userIDs[0]
+
+	groupID := plan.GroupID.ValueString()
+	if err := r.client.AddGroupUsers(ctx, groupID, []string{userID}); err != nil {
+		resp.Diagnostics.AddError("Add group member failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(groupID + "/" + userID)
+	plan.UserID = types.StringValue(userID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks that the user is still a member of the group.
+func (r *groupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing group members.")
+		return
+	}
+
+	var state groupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, state.GroupID.ValueString())
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read group failed", err.Error())
+		return
+	}
+
+	member := false
+	for _, id := range group.UserIDs {
+		if id == state.UserID.ValueString() {
+			member = true
+			break
+		}
+	}
+	if !member {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Imported memberships only know the user ID; label them with the email address.
+	if state.User.IsNull() || state.User.ValueString() == "" {
+		user, err := r.client.GetUser(ctx, state.UserID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read user failed", err.Error())
+			return
+		}
+		state.User = types.StringValue(user.Email)
+	}
+
+	state.ID = types.StringValue(state.GroupID.ValueString() + "/" + state.UserID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update records a new identifier for the same user or a new user_match; every other
+// change forces replacement.
+func (r *groupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the user from the group.
+func (r *groupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing group members.")
+		return
+	}
+
+	var state groupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RemoveGroupUsers(ctx, state.GroupID.ValueString(), []string{state.UserID.ValueString()}); err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Remove group member failed", err.Error())
+		return
+	}
+}
+
+// ImportState accepts identifiers in the form <group_id>/<user_id>.
+func (r *groupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, userID, ok := strings.Cut(req.ID, "/")
+	if !ok || groupID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			fmt.Sprintf("Expected an identifier in the form <group_id>/<user_id>, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+func TestLookupUserExact(t *testing.T) {
+	username := func(value string) *string { return &value }
+
+	alice := client.User{ID: "id-alice", Name: "Alice", Email: "alice@example.com", Username: username("alice")}
+	alicia := client.User{ID: "id-alicia", Name: "Alicia", Email: "alicia@example.com", Username: username("alicia")}
+	bob := client.User{ID: "id-bob", Name: "Bob", Email: "bob@example.com"}
+	// A second account whose username equals another user's email address.
+	impostor := client.User{ID: "id-impostor", Name: "Impostor", Email: "other@example.com", Username: username("alice@example.com")}
+
+	apiClient := newTestClient(t, map[string]any{
+		"/users/id-carol": client.User{ID: "id-carol", Name: "Carol", Email: "carol@example.com"},
+	})
+
+	tests := []struct {
+		name       string
+		identifier string
+		users      []client.User
+		wantID     string
+		wantErr    string
+	}{
+		{name: "email", identifier: "alice@example.com", users: []client.User{alice, alicia}, wantID: "id-alice"},
+		{name: "email ignores case", identifier: "ALICE@example.com", users: []client.User{alice, alicia}, wantID: "id-alice"},
+		{name: "username", identifier: "alicia", users: []client.User{alice, alicia}, wantID: "id-alicia"},
+		{name: "ID in the search results", identifier: "id-bob", users: []client.User{bob}, wantID: "id-bob"},
+		{name: "ID fetched directly", identifier: "id-carol", users: nil, wantID: "id-carol"},
+		{name: "display name is not exact", identifier: "Bob", users: []client.User{bob}, wantErr: "similar users: bob@example.com [id-bob]"},
+		{name: "prefix is not exact", identifier: "ali", users: []client.User{alice, alicia}, wantErr: "similar users: alice@example.com (alice) [id-alice], alicia@example.com (alicia) [id-alicia]"},
+		{name: "no candidates", identifier: "nobody", users: nil, wantErr: "no user has this email address, username or ID"},
+		{name: "ambiguous", identifier: "alice@example.com", users: []client.User{alice, impostor}, wantErr: "2 users match: alice@example.com (alice) [id-alice], other@example.com (alice@example.com) [id-impostor]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lookupUserExact(context.Background(), apiClient, tt.identifier, tt.users)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("lookupUserExact(%q) error = %v, want it to contain %q", tt.identifier, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("lookupUserExact(%q) error = %v", tt.identifier, err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("lookupUserExact(%q) = %s, want %s", tt.identifier, got.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -2,14 +2,22 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
@@ -18,24 +26,45 @@ import (
 var _ resource.Resource = &knowledgeResource{}
 var _ resource.ResourceWithConfigure = &knowledgeResource{}
 var _ resource.ResourceWithImportState = &knowledgeResource{}
+var _ resource.ResourceWithUpgradeState = &knowledgeResource{}
+var _ resource.ResourceWithModifyPlan = &knowledgeResource{}
 
 // knowledgeResource implements the Terraform resource for Open WebUI knowledge bases.
 type knowledgeResource struct {
-	client *client.Client
+	client    *client.Client
+	userMatch string
 }
 
 // knowledgeResourceModel maps the resource schema data.
 type knowledgeResourceModel struct {
+	knowledgeEntryModel
+	Source                 *knowledgeSourceModel `tfsdk:"source"`
+	Files                  types.Map             `tfsdk:"files"`
+	ProcessingTimeout      types.String          `tfsdk:"processing_timeout"`
+	ReindexTrigger         types.String          `tfsdk:"reindex_trigger"`
+	LastReindexedAt        types.String          `tfsdk:"last_reindexed_at"`
+	LastReindexedFileCount types.Int64           `tfsdk:"last_reindexed_file_count"`
+}
+
+// knowledgeSourceModel describes a local directory synchronised into the knowledge entry.
+type knowledgeSourceModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Pattern   types.String `tfsdk:"pattern"`
+	Hashes    types.Map    `tfsdk:"hashes"`
+}
+
+// knowledgeEntryModel holds the attributes shared by the knowledge resource and data source.
+type knowledgeEntryModel struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	DataJSON    types.String `tfsdk:"data_json"`
 	MetaJSON    types.String `tfsdk:"meta_json"`
-	ReadGroups  types.List   `tfsdk:"read_groups"`
-	WriteGroups types.List   `tfsdk:"write_groups"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
 	UserID      types.String `tfsdk:"user_id"`
+	FileCount   types.Int64  `tfsdk:"file_count"`
+	accessControlModel
 }
 
 // NewKnowledgeResource returns a new instance.
@@ -51,6 +80,7 @@ func (r *knowledgeResource) Metadata(_ context.Context, req resource.MetadataReq
 // Schema describes the resource schema.
 func (r *knowledgeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:      true,
@@ -77,19 +107,59 @@ func (r *knowledgeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description:   "JSON payload describing metadata for the knowledge entry.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"read_groups": schema.ListAttribute{
+			"read_groups": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Group names or IDs granted read access to the knowledge entry.",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenConfigured(path.Root("read_group_ids"), path.Root("write_group_ids")), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
 			},
-			"write_groups": schema.ListAttribute{
+			"write_groups": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Group names or IDs granted write access to the knowledge entry.",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenConfigured(path.Root("read_group_ids"), path.Root("write_group_ids")), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"read_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group IDs granted read access to the knowledge entry, used verbatim. Conflicts with `read_groups` and `write_groups`.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("read_groups"), path.MatchRoot("write_groups")),
+				},
+			},
+			"write_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group IDs granted write access to the knowledge entry (also receive read access), used verbatim. Conflicts with `read_groups` and `write_groups`.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("read_groups"), path.MatchRoot("write_groups")),
+				},
+			},
+			"read_users": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email addresses, usernames or IDs of users granted read access to the knowledge entry.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"write_users": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email addresses, usernames or IDs of users granted write access to the knowledge entry (also receive read access).",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"visibility": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Who can access the knowledge entry: `public` (everyone), `private` (only the owner) or `restricted` (the owner plus the granted groups and users). Defaults to `restricted` when grants are configured; otherwise the current visibility is kept, and new records without grants are public.",
+				PlanModifiers: []planmodifier.String{visibilityPlanModifier{}},
+				Validators: []validator.String{
+					stringvalidator.OneOf(visibilityPublic, visibilityPrivate, visibilityRestricted),
+					visibilityValidator{},
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Computed:      true,
@@ -105,6 +175,56 @@ func (r *knowledgeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Description: "Identifier of the user who owns the knowledge entry.",
 			},
+			"source": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Local directory synchronised into the knowledge entry. Matching files are uploaded on apply, re-uploaded when their content changes and removed when deleted locally.",
+				Attributes: map[string]schema.Attribute{
+					"directory": schema.StringAttribute{
+						Required:    true,
+						Description: "Path to the local directory containing the files to synchronise.",
+					},
+					"pattern": schema.StringAttribute{
+						Required:    true,
+						Description: "Glob relative to `directory` selecting the files to synchronise. `**` matches any number of nested directories, for example `docs/**/*.md`.",
+					},
+					"hashes": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "SHA-256 content hash of each synchronised file, keyed by path relative to `directory`.",
+					},
+				},
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Open WebUI file IDs of the files synchronised from `source`, keyed by path relative to `directory`.",
+			},
+			"processing_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultFileProcessingTimeout),
+				Description: "Maximum time to wait for Open WebUI to extract and embed each uploaded file, for example `30s` or `15m`. Defaults to `10m`.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"reindex_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, resets the knowledge entry and re-attaches all of its files so they are embedded again, for example after changing the embedding model or chunking settings.",
+			},
+			"last_reindexed_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "RFC 3339 timestamp of the last reindex triggered through `reindex_trigger`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"last_reindexed_file_count": schema.Int64Attribute{
+				Computed:      true,
+				Description:   "Number of files re-attached during the last reindex.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"file_count": schema.Int64Attribute{
+				Computed:      true,
+				Description:   "Number of files attached to the knowledge entry.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
 		},
 	}
 }
@@ -115,8 +235,9 @@ func (r *knowledgeResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.userMatch = data.userMatch
 	}
 }
 
@@ -138,12 +259,7 @@ func (r *knowledgeResource) Create(ctx context.Context, req resource.CreateReque
 		Description: plan.Description.ValueString(),
 	}
 
-	readNames := expandStringList(ctx, plan.ReadGroups, path.Root("read_groups"), &resp.Diagnostics)
-	writeNames := expandStringList(ctx, plan.WriteGroups, path.Root("write_groups"), &resp.Diagnostics)
-	readIDs := resolveGroupNamesToIDs(ctx, r.client, readNames, path.Root("read_groups"), &resp.Diagnostics)
-	writeIDs := resolveGroupNamesToIDs(ctx, r.client, writeNames, path.Root("write_groups"), &resp.Diagnostics)
-
-	form.AccessControl = buildAccessControl(readIDs, writeIDs)
+	form.AccessControl = expandAccessControl(ctx, r.client, plan.accessControlModel, r.userMatch, &resp.Diagnostics)
 	form.Data = decodeOptionalJSON(plan.DataJSON, path.Root("data_json"), &resp.Diagnostics)
 	form.Meta = decodeOptionalJSON(plan.MetaJSON, path.Root("meta_json"), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -156,6 +272,14 @@ func (r *knowledgeResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	var files, hashes map[string]string
+	if plan.Source != nil {
+		desired := desiredSourceHashes(ctx, plan.Source, &resp.Diagnostics)
+		if !resp.Diagnostics.HasError() {
+			files, hashes = syncKnowledgeSource(ctx, r.client, created.ID, plan.Source.Directory.ValueString(), desired, nil, nil, parseProcessingTimeout(plan.ProcessingTimeout), &resp.Diagnostics)
+		}
+	}
+
 	// Fetch the latest representation to populate computed fields consistently.
 	current, err := r.client.GetKnowledge(ctx, created.ID)
 	if err != nil {
@@ -163,12 +287,18 @@ func (r *knowledgeResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	state, diags := knowledgeResponseToModel(ctx, r.client, *current)
+	entry, diags := knowledgeResponseToModel(ctx, r.client, *current, plan.accessControlModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 
+	// State is persisted even when synchronisation failed so already uploaded files stay tracked.
+	state := knowledgeResourceModel{
+		knowledgeEntryModel:    entry,
+		ProcessingTimeout:      plan.ProcessingTimeout,
+		ReindexTrigger:         plan.ReindexTrigger,
+		LastReindexedAt:        types.StringNull(),
+		LastReindexedFileCount: types.Int64Null(),
+	}
+	resp.Diagnostics.Append(setKnowledgeSourceState(ctx, &state, plan.Source, files, hashes)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -196,12 +326,37 @@ func (r *knowledgeResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	updated, diags := knowledgeResponseToModel(ctx, r.client, *current)
+	entry, diags := knowledgeResponseToModel(ctx, r.client, *current, state.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updated := knowledgeResourceModel{
+		knowledgeEntryModel:    entry,
+		ProcessingTimeout:      state.ProcessingTimeout,
+		ReindexTrigger:         state.ReindexTrigger,
+		LastReindexedAt:        state.LastReindexedAt,
+		LastReindexedFileCount: state.LastReindexedFileCount,
+	}
+	if updated.ProcessingTimeout.IsNull() {
+		updated.ProcessingTimeout = types.StringValue(defaultFileProcessingTimeout)
+	}
+
+	var files, hashes map[string]string
+	if state.Source != nil {
+		files, hashes = filterAttachedSourceFiles(
+			expandStringMap(ctx, state.Files, &resp.Diagnostics),
+			expandStringMap(ctx, state.Source.Hashes, &resp.Diagnostics),
+			current.Files,
+		)
+	}
+
+	resp.Diagnostics.Append(setKnowledgeSourceState(ctx, &updated, state.Source, files, hashes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
 }
 
@@ -218,17 +373,18 @@ func (r *knowledgeResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	var prior knowledgeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	form := client.KnowledgeForm{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
 	}
 
-	readNames := expandStringList(ctx, plan.ReadGroups, path.Root("read_groups"), &resp.Diagnostics)
-	writeNames := expandStringList(ctx, plan.WriteGroups, path.Root("write_groups"), &resp.Diagnostics)
-	readIDs := resolveGroupNamesToIDs(ctx, r.client, readNames, path.Root("read_groups"), &resp.Diagnostics)
-	writeIDs := resolveGroupNamesToIDs(ctx, r.client, writeNames, path.Root("write_groups"), &resp.Diagnostics)
-
-	form.AccessControl = buildAccessControl(readIDs, writeIDs)
+	form.AccessControl = expandAccessControl(ctx, r.client, plan.accessControlModel, r.userMatch, &resp.Diagnostics)
 	form.Data = decodeOptionalJSON(plan.DataJSON, path.Root("data_json"), &resp.Diagnostics)
 	form.Meta = decodeOptionalJSON(plan.MetaJSON, path.Root("meta_json"), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -241,18 +397,82 @@ func (r *knowledgeResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	priorFiles := expandStringMap(ctx, prior.Files, &resp.Diagnostics)
+	var priorHashes map[string]string
+	if prior.Source != nil {
+		priorHashes = expandStringMap(ctx, prior.Source.Hashes, &resp.Diagnostics)
+	}
+
+	// Removing the source detaches every file it previously synchronised.
+	var desired map[string]string
+	directory := ""
+	if plan.Source != nil {
+		desired = desiredSourceHashes(ctx, plan.Source, &resp.Diagnostics)
+		directory = plan.Source.Directory.ValueString()
+	}
+
+	files, hashes := priorFiles, priorHashes
+	if !resp.Diagnostics.HasError() {
+		files, hashes = syncKnowledgeSource(ctx, r.client, plan.ID.ValueString(), directory, desired, priorHashes, priorFiles, parseProcessingTimeout(plan.ProcessingTimeout), &resp.Diagnostics)
+	}
+
+	lastReindexedAt := prior.LastReindexedAt
+	lastReindexedFileCount := prior.LastReindexedFileCount
+	if !resp.Diagnostics.HasError() && reindexRequested(prior.ReindexTrigger, plan.ReindexTrigger) {
+		pending := map[string]string{}
+		raw, diags := req.Private.GetKey(ctx, reindexPendingPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &pending); err != nil {
+				resp.Diagnostics.AddError("Decode private data", err.Error())
+			}
+		}
+
+		if !resp.Diagnostics.HasError() {
+			count, remaining := reindexKnowledge(ctx, r.client, plan.ID.ValueString(), pending, &resp.Diagnostics)
+			if !resp.Diagnostics.HasError() {
+				lastReindexedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+				lastReindexedFileCount = types.Int64Value(int64(count))
+			}
+
+			// An empty value removes the key once every file is attached again.
+			raw = nil
+			if len(remaining) > 0 {
+				if raw, err = json.Marshal(remaining); err != nil {
+					resp.Diagnostics.AddError("Serialize private data", err.Error())
+				}
+			}
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, reindexPendingPrivateKey, raw)...)
+		}
+	}
+
 	current, err := r.client.GetKnowledge(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Read knowledge entry failed", err.Error())
 		return
 	}
 
-	state, diags := knowledgeResponseToModel(ctx, r.client, *current)
+	entry, diags := knowledgeResponseToModel(ctx, r.client, *current, plan.accessControlModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+
+	source := plan.Source
+	if source == nil && len(files) > 0 {
+		// Keep tracking files that could not be detached so the next apply retries.
+		source = prior.Source
 	}
 
+	state := knowledgeResourceModel{
+		knowledgeEntryModel:    entry,
+		ProcessingTimeout:      plan.ProcessingTimeout,
+		ReindexTrigger:         plan.ReindexTrigger,
+		LastReindexedAt:        lastReindexedAt,
+		LastReindexedFileCount: lastReindexedFileCount,
+	}
+	if resp.Diagnostics.HasError() && reindexRequested(prior.ReindexTrigger, plan.ReindexTrigger) {
+		// Keep the previous trigger so a failed reindex is retried on the next apply.
+		state.ReindexTrigger = prior.ReindexTrigger
+	}
+	resp.Diagnostics.Append(setKnowledgeSourceState(ctx, &state, source, files, hashes)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -278,6 +498,23 @@ func (r *knowledgeResource) Delete(ctx context.Context, req resource.DeleteReque
 		resp.Diagnostics.AddError("Delete knowledge entry failed", err.Error())
 		return
 	}
+
+	// Files synchronised from a source are owned by this resource and removed with it.
+	for rel, fileID := range expandStringMap(ctx, state.Files, &resp.Diagnostics) {
+		if err := r.client.DeleteFile(ctx, fileID); err != nil && err != client.ErrNotFound {
+			resp.Diagnostics.AddWarning(
+				"Delete source file failed",
+				fmt.Sprintf("The knowledge entry was deleted, but the file synchronised from %s (%s) could not be removed: %v", rel, fileID, err),
+			)
+		}
+	}
+}
+
+// UpgradeState converts state written while the string collections were lists.
+func (r *knowledgeResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeListsToSets("read_groups", "write_groups", "read_group_ids", "write_group_ids", "read_users", "write_users"),
+	}
 }
 
 // ImportState maps imported IDs to the id attribute.
@@ -285,57 +522,211 @@ func (r *knowledgeResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// knowledgeResponseToModel maps API structures to Terraform state.
-func knowledgeResponseToModel(ctx context.Context, apiClient *client.Client, resp client.KnowledgeFilesResponse) (knowledgeResourceModel, diag.Diagnostics) {
-	var diags diag.Diagnostics
+// ModifyPlan scans the configured source directory so the plan shows a per-file diff.
+func (r *knowledgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-	data, err := encodeOptionalJSON(resp.Data)
+	var plan knowledgeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior knowledgeResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if reindexRequested(prior.ReindexTrigger, plan.ReindexTrigger) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_reindexed_at"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_reindexed_file_count"), types.Int64Unknown())...)
+		}
+	}
+
+	if plan.Source == nil {
+		if !prior.Files.IsNull() && len(prior.Files.Elements()) > 0 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Unknown())...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), types.MapNull(types.StringType))...)
+		return
+	}
+
+	hashesPath := path.Root("source").AtName("hashes")
+	if plan.Source.Directory.IsUnknown() || plan.Source.Pattern.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, hashesPath, types.MapUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Unknown())...)
+		return
+	}
+
+	hashes, err := scanKnowledgeSource(plan.Source.Directory.ValueString(), plan.Source.Pattern.ValueString())
 	if err != nil {
-		diags.AddError("Serialize data", err.Error())
+		resp.Diagnostics.AddAttributeError(path.Root("source").AtName("directory"), "Unable to scan knowledge source", err.Error())
+		return
 	}
 
-	meta, err := encodeOptionalJSON(resp.Meta)
+	priorFiles := expandStringMap(ctx, prior.Files, &resp.Diagnostics)
+	var priorHashes map[string]string
+	if prior.Source != nil {
+		priorHashes = expandStringMap(ctx, prior.Source.Hashes, &resp.Diagnostics)
+	}
+
+	hashesValue, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, hashesPath, hashesValue)...)
+	plannedFiles := planKnowledgeSourceFiles(hashes, priorHashes, priorFiles)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), plannedFiles)...)
+	if !plannedFiles.Equal(prior.Files) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Unknown())...)
+	}
+}
+
+// reindexRequested reports whether reindex_trigger changed to a new non-null value.
+func reindexRequested(prior, planned types.String) bool {
+	if planned.IsNull() {
+		return false
+	}
+
+	return !planned.Equal(prior)
+}
+
+// reindexPendingPrivateKey stores the files a failed reindex detached but could not
+// re-attach, keyed by file ID with the filename as value, so the retry restores them.
+const reindexPendingPrivateKey = "reindex_pending_files"
+
+// reindexKnowledge resets a knowledge entry and re-attaches every file that was attached
+// beforehand, along with the pending files a previous reindex failed to re-attach. Open
+// WebUI re-embeds each file before the attach call returns, so a file counts as reindexed
+// once the returned entry lists it again. It returns the number of files re-attached and
+// the files that are still pending.
+func reindexKnowledge(ctx context.Context, apiClient *client.Client, knowledgeID string, pending map[string]string, diags *diag.Diagnostics) (int, map[string]string) {
+	current, err := apiClient.GetKnowledge(ctx, knowledgeID)
 	if err != nil {
-		diags.AddError("Serialize metadata", err.Error())
+		diags.AddError("Read knowledge entry failed", err.Error())
+		return 0, pending
 	}
 
-	readIDs := extractGroupIDsFromAccessControl(resp.AccessControl, "read")
-	writeIDs := extractGroupIDsFromAccessControl(resp.AccessControl, "write")
+	// Record the file list before the reset detaches it.
+	files := make(map[string]string, len(current.Files)+len(pending))
+	for id, filename := range pending {
+		files[id] = filename
+	}
+	for _, file := range current.Files {
+		files[file.ID] = file.Filename
+	}
 
-	readNames, readDiags := fetchGroupNamesForIDs(ctx, apiClient, readIDs)
-	diags.Append(readDiags...)
-	writeNames, writeDiags := fetchGroupNamesForIDs(ctx, apiClient, writeIDs)
-	diags.Append(writeDiags...)
+	if _, err := apiClient.ResetKnowledge(ctx, knowledgeID); err != nil {
+		diags.AddError("Reset knowledge entry failed", err.Error())
+		return 0, pending
+	}
 
-	readList := types.ListNull(types.StringType)
-	if len(readNames) > 0 {
-		l, listDiags := types.ListValueFrom(ctx, types.StringType, readNames)
-		diags.Append(listDiags...)
-		if !listDiags.HasError() {
-			readList = l
+	count := 0
+	remaining := map[string]string{}
+	for _, id := range sortedKeys(files) {
+		filename := files[id]
+		attached, err := apiClient.AddKnowledgeFile(ctx, knowledgeID, id)
+		if err != nil {
+			if err == client.ErrNotFound {
+				diags.AddWarning("Knowledge file no longer exists", fmt.Sprintf("%s (%s) was deleted and is not re-attached.", filename, id))
+				continue
+			}
+			diags.AddError("Re-attach knowledge file failed", fmt.Sprintf("Unable to re-attach %s (%s) after reset: %v", filename, id, err))
+			remaining[id] = filename
+			continue
 		}
+		if !knowledgeHasFile(attached, id) {
+			diags.AddError("Re-attach knowledge file failed", fmt.Sprintf("Open WebUI did not add %s (%s) back to the knowledge entry after reset.", filename, id))
+			remaining[id] = filename
+			continue
+		}
+
+		count++
 	}
 
-	writeList := types.ListNull(types.StringType)
-	if len(writeNames) > 0 {
-		l, listDiags := types.ListValueFrom(ctx, types.StringType, writeNames)
-		diags.Append(listDiags...)
-		if !listDiags.HasError() {
-			writeList = l
-		}
+	return count, remaining
+}
+
+// desiredSourceHashes returns the planned content hashes, scanning the directory when
+// they could not be computed at plan time.
+func desiredSourceHashes(ctx context.Context, source *knowledgeSourceModel, diags *diag.Diagnostics) map[string]string {
+	if !source.Hashes.IsNull() && !source.Hashes.IsUnknown() {
+		return expandStringMap(ctx, source.Hashes, diags)
+	}
+
+	hashes, err := scanKnowledgeSource(source.Directory.ValueString(), source.Pattern.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("source").AtName("directory"), "Unable to scan knowledge source", err.Error())
+		return nil
+	}
+
+	return hashes
+}
+
+// setKnowledgeSourceState records the source configuration and synchronised files on the model.
+func setKnowledgeSourceState(ctx context.Context, model *knowledgeResourceModel, source *knowledgeSourceModel, files, hashes map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Files = types.MapNull(types.StringType)
+	if source == nil {
+		model.Source = nil
+		return diags
+	}
+
+	if files == nil {
+		files = map[string]string{}
+	}
+	if hashes == nil {
+		hashes = map[string]string{}
+	}
+
+	filesValue, filesDiags := types.MapValueFrom(ctx, types.StringType, files)
+	diags.Append(filesDiags...)
+	hashesValue, hashesDiags := types.MapValueFrom(ctx, types.StringType, hashes)
+	diags.Append(hashesDiags...)
+
+	model.Files = filesValue
+	model.Source = &knowledgeSourceModel{
+		Directory: source.Directory,
+		Pattern:   source.Pattern,
+		Hashes:    hashesValue,
+	}
+
+	return diags
+}
+
+// knowledgeResponseToModel maps API structures to Terraform state.
+func knowledgeResponseToModel(ctx context.Context, apiClient *client.Client, resp client.KnowledgeFilesResponse, prior accessControlModel) (knowledgeEntryModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, err := encodeOptionalJSON(resp.Data)
+	if err != nil {
+		diags.AddError("Serialize data", err.Error())
+	}
+
+	meta, err := encodeOptionalJSON(resp.Meta)
+	if err != nil {
+		diags.AddError("Serialize metadata", err.Error())
 	}
 
-	model := knowledgeResourceModel{
-		ID:          types.StringValue(resp.ID),
-		Name:        types.StringValue(resp.Name),
-		Description: types.StringValue(resp.Description),
-		DataJSON:    data,
-		MetaJSON:    meta,
-		ReadGroups:  readList,
-		WriteGroups: writeList,
-		CreatedAt:   formatDateValue(resp.CreatedAt),
-		UpdatedAt:   formatDateValue(resp.UpdatedAt),
-		UserID:      types.StringValue(resp.UserID),
+	model := knowledgeEntryModel{
+		ID:                 types.StringValue(resp.ID),
+		Name:               types.StringValue(resp.Name),
+		Description:        types.StringValue(resp.Description),
+		DataJSON:           data,
+		MetaJSON:           meta,
+		CreatedAt:          formatDateValue(resp.CreatedAt),
+		UpdatedAt:          formatDateValue(resp.UpdatedAt),
+		UserID:             types.StringValue(resp.UserID),
+		FileCount:          types.Int64Value(int64(len(resp.Files))),
+		accessControlModel: flattenAccessControl(ctx, apiClient, resp.AccessControl, prior, &diags),
 	}
 
 	return model, diags
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &memoryResource{}
+var _ resource.ResourceWithConfigure = &memoryResource{}
+var _ resource.ResourceWithImportState = &memoryResource{}
+
+// memoryResource manages memories of the identity the provider authenticates as.
+type memoryResource struct {
+	client *client.Client
+}
+
+// memoryResourceModel maps Terraform state.
+type memoryResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Content   types.String `tfsdk:"content"`
+	UserID    types.String `tfsdk:"user_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// NewMemoryResource constructs a new resource instance.
+func NewMemoryResource() resource.Resource {
+	return &memoryResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *memoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_memory"
+}
+
+// Schema defines the resource schema for memories.
+func (r *memoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Memory identifier.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"content": schema.StringAttribute{
+				Required:    true,
+				Description: "Text of the memory.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the user who owns the memory.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Creation date in YYYY-MM-DD format.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date in YYYY-MM-DD format.",
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *memoryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+// Create adds the memory.
+func (r *memoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing memories.")
+		return
+	}
+
+	var plan memoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.AddMemory(ctx, client.MemoryForm{Content: plan.Content.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Create memory failed", err.Error())
+		return
+	}
+	if created.ID == "" {
+		resp.Diagnostics.AddError("Create memory failed", "Open WebUI did not return the new memory. Ensure the memory feature is enabled for the provider's identity.")
+		return
+	}
+
+	state := memoryResponseToModel(*created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the memory from the API.
+func (r *memoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing memories.")
+		return
+	}
+
+	var state memoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memory, err := r.client.GetMemory(ctx, state.ID.ValueString())
+	if err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read memory failed", err.Error())
+		return
+	}
+
+	updated := memoryResponseToModel(*memory)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Update replaces the memory content.
+func (r *memoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing memories.")
+		return
+	}
+
+	var plan, state memoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memory, err := r.client.UpdateMemory(ctx, state.ID.ValueString(), client.MemoryForm{Content: plan.Content.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Update memory failed", err.Error())
+		return
+	}
+	if memory.ID == "" {
+		memory, err = r.client.GetMemory(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read memory failed", err.Error())
+			return
+		}
+	}
+
+	updated := memoryResponseToModel(*memory)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Delete removes the memory.
+func (r *memoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing memories.")
+		return
+	}
+
+	var state memoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteMemory(ctx, state.ID.ValueString()); err != nil {
+		if err == client.ErrNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Delete memory failed", err.Error())
+		return
+	}
+}
+
+// ImportState supports terraform import by memory ID.
+func (r *memoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// memoryResponseToModel converts an API memory into Terraform state.
+func memoryResponseToModel(memory client.MemoryModel) memoryResourceModel {
+	return memoryResourceModel{
+		ID:        types.StringValue(memory.ID),
+		Content:   types.StringValue(memory.Content),
+		UserID:    types.StringValue(memory.UserID),
+		CreatedAt: formatDateValue(memory.CreatedAt),
+		UpdatedAt: formatDateValue(memory.UpdatedAt),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
@@ -21,10 +25,14 @@ import (
 var _ resource.Resource = &modelResource{}
 var _ resource.ResourceWithConfigure = &modelResource{}
 var _ resource.ResourceWithImportState = &modelResource{}
+var _ resource.ResourceWithUpgradeState = &modelResource{}
+var _ resource.ResourceWithModifyPlan = &modelResource{}
 
 // modelResource implements the Terraform resource for Open WebUI models.
 type modelResource struct {
-	client *client.Client
+	client     *client.Client
+	userMatch  string
+	baseModels *baseModelCatalog
 }
 
 // modelResourceModel captures Terraform state and plan data.
@@ -40,15 +48,14 @@ type modelResourceModel struct {
 	MetaAdditionalJSON   types.String            `tfsdk:"meta_additional_json"`
 	Params               *modelParamsModel       `tfsdk:"params"`
 	ParamsAdditionalJSON types.String            `tfsdk:"params_additional_json"`
-	ReadGroups           types.List              `tfsdk:"read_groups"`
-	WriteGroups          types.List              `tfsdk:"write_groups"`
 	ProfileImageURL      types.String            `tfsdk:"profile_image_url"`
 	Description          types.String            `tfsdk:"description"`
 	SuggestionPrompts    types.List              `tfsdk:"suggestion_prompts"`
-	Tags                 types.List              `tfsdk:"tags"`
+	Tags                 types.Set               `tfsdk:"tags"`
 	ToolIDs              types.List              `tfsdk:"tool_ids"`
 	DefaultFeatureIDs    types.List              `tfsdk:"default_feature_ids"`
 	Capabilities         *modelCapabilitiesModel `tfsdk:"capabilities"`
+	accessControlModel
 }
 
 // modelCapabilitiesModel captures boolean capability toggles.
@@ -103,7 +110,7 @@ type modelMetaState struct {
 	ProfileImageURL   types.String
 	Description       types.String
 	SuggestionPrompts types.List
-	Tags              types.List
+	Tags              types.Set
 	ToolIDs           types.List
 	DefaultFeatureIDs types.List
 	Capabilities      *modelCapabilitiesModel
@@ -122,6 +129,7 @@ func (r *modelResource) Metadata(_ context.Context, req resource.MetadataRequest
 // Schema defines the Terraform schema for the model resource.
 func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:      true,
@@ -138,7 +146,7 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"base_model_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Optional base model identifier.",
+				Description: "Optional base model identifier. New or changed values are checked against the `openwebui_base_models` catalogue at plan time.",
 			},
 			"is_active": schema.BoolAttribute{
 				Optional:      true,
@@ -172,19 +180,59 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description:   "Raw JSON fragment merged into the params payload for fields not covered by dedicated arguments.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"read_groups": schema.ListAttribute{
+			"read_groups": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Group names or IDs granted read access to the model.",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenConfigured(path.Root("read_group_ids"), path.Root("write_group_ids")), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
 			},
-			"write_groups": schema.ListAttribute{
+			"write_groups": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Group names or IDs granted write access to the model.",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenConfigured(path.Root("read_group_ids"), path.Root("write_group_ids")), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"read_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group IDs granted read access to the model, used verbatim. Conflicts with `read_groups` and `write_groups`.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("read_groups"), path.MatchRoot("write_groups")),
+				},
+			},
+			"write_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group IDs granted write access to the model (also receive read access), used verbatim. Conflicts with `read_groups` and `write_groups`.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("read_groups"), path.MatchRoot("write_groups")),
+				},
+			},
+			"read_users": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email addresses, usernames or IDs of users granted read access to the model.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"write_users": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email addresses, usernames or IDs of users granted write access to the model (also receive read access).",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"visibility": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Who can access the model: `public` (everyone), `private` (only the owner) or `restricted` (the owner plus the granted groups and users). Defaults to `restricted` when grants are configured; otherwise the current visibility is kept, and new records without grants are public.",
+				PlanModifiers: []planmodifier.String{visibilityPlanModifier{}},
+				Validators: []validator.String{
+					stringvalidator.OneOf(visibilityPublic, visibilityPrivate, visibilityRestricted),
+					visibilityValidator{},
+				},
 			},
 			"profile_image_url": schema.StringAttribute{
 				Optional:      true,
@@ -205,12 +253,12 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description:   "Prompt suggestions surfaced to end users when selecting the model.",
 				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
-			"tags": schema.ListAttribute{
+			"tags": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Tags associated with the model.",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
 			},
 			"tool_ids": schema.ListAttribute{
 				ElementType:   types.StringType,
@@ -327,11 +375,37 @@ func (r *modelResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.userMatch = data.userMatch
+		r.baseModels = data.baseModels
 	}
 }
 
+// ModifyPlan checks a new or changed base_model_id against the base models Open WebUI
+// offers, so typos fail the plan instead of the first chat.
+func (r *modelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planned types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("base_model_id"), &planned)...)
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var prior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("base_model_id"), &prior)...)
+		if resp.Diagnostics.HasError() || prior.Equal(planned) {
+			return
+		}
+	}
+
+	validateBaseModelID(ctx, r.client, r.baseModels, planned.ValueString(), path.Root("base_model_id"), &resp.Diagnostics)
+}
+
 // Create provisions the model through the API.
 func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
@@ -375,11 +449,7 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 		Params: copyStringAnyMap(paramsMap),
 	}
 
-	readNames := expandStringList(ctx, plan.ReadGroups, path.Root("read_groups"), &resp.Diagnostics)
-	writeNames := expandStringList(ctx, plan.WriteGroups, path.Root("write_groups"), &resp.Diagnostics)
-	readIDs := resolveGroupNamesToIDs(ctx, r.client, readNames, path.Root("read_groups"), &resp.Diagnostics)
-	writeIDs := resolveGroupNamesToIDs(ctx, r.client, writeNames, path.Root("write_groups"), &resp.Diagnostics)
-	form.AccessControl = buildAccessControl(readIDs, writeIDs)
+	form.AccessControl = expandAccessControl(ctx, r.client, plan.accessControlModel, r.userMatch, &resp.Diagnostics)
 
 	if !plan.BaseModelID.IsNull() && !plan.BaseModelID.IsUnknown() && plan.BaseModelID.ValueString() != "" {
 		base := plan.BaseModelID.ValueString()
@@ -401,7 +471,7 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	state, diags := modelResponseToModel(ctx, r.client, created, plan.ModelID.ValueString())
+	state, diags := modelResponseToModel(ctx, r.client, created, plan.ModelID.ValueString(), plan.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -433,7 +503,7 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	updated, diags := modelResponseToModel(ctx, r.client, current, state.ModelID.ValueString())
+	updated, diags := modelResponseToModel(ctx, r.client, current, state.ModelID.ValueString(), state.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -485,11 +555,7 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Params: copyStringAnyMap(paramsMap),
 	}
 
-	readNames := expandStringList(ctx, plan.ReadGroups, path.Root("read_groups"), &resp.Diagnostics)
-	writeNames := expandStringList(ctx, plan.WriteGroups, path.Root("write_groups"), &resp.Diagnostics)
-	readIDs := resolveGroupNamesToIDs(ctx, r.client, readNames, path.Root("read_groups"), &resp.Diagnostics)
-	writeIDs := resolveGroupNamesToIDs(ctx, r.client, writeNames, path.Root("write_groups"), &resp.Diagnostics)
-	form.AccessControl = buildAccessControl(readIDs, writeIDs)
+	form.AccessControl = expandAccessControl(ctx, r.client, plan.accessControlModel, r.userMatch, &resp.Diagnostics)
 
 	if !plan.BaseModelID.IsNull() && !plan.BaseModelID.IsUnknown() && plan.BaseModelID.ValueString() != "" {
 		base := plan.BaseModelID.ValueString()
@@ -517,7 +583,7 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	state, diags := modelResponseToModel(ctx, r.client, current, plan.ModelID.ValueString())
+	state, diags := modelResponseToModel(ctx, r.client, current, plan.ModelID.ValueString(), plan.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -549,6 +615,13 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// UpgradeState converts state written while the string collections were lists.
+func (r *modelResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeListsToSets("tags", "read_groups", "write_groups", "read_group_ids", "write_group_ids", "read_users", "write_users"),
+	}
+}
+
 // ImportState maps import IDs onto the id attribute.
 func (r *modelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -556,7 +629,7 @@ func (r *modelResource) ImportState(ctx context.Context, req resource.ImportStat
 }
 
 // modelResponseToModel maps API responses to Terraform state structures.
-func modelResponseToModel(ctx context.Context, apiClient *client.Client, resp *client.ModelResponse, requestedID string) (modelResourceModel, diag.Diagnostics) {
+func modelResponseToModel(ctx context.Context, apiClient *client.Client, resp *client.ModelResponse, requestedID string, prior accessControlModel) (modelResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	paramsModel, paramsAdditional, paramsDiags := flattenModelParams(ctx, resp.Params)
@@ -564,19 +637,6 @@ func modelResponseToModel(ctx context.Context, apiClient *client.Client, resp *c
 	metaState, metaAdditional, metaDiags := flattenModelMeta(ctx, resp.Meta)
 	diags.Append(metaDiags...)
 
-	readIDs := extractGroupIDsFromAccessControl(resp.AccessControl, "read")
-	writeIDs := extractGroupIDsFromAccessControl(resp.AccessControl, "write")
-
-	readNames, readDiags := fetchGroupNamesForIDs(ctx, apiClient, readIDs)
-	diags.Append(readDiags...)
-	writeNames, writeDiags := fetchGroupNamesForIDs(ctx, apiClient, writeIDs)
-	diags.Append(writeDiags...)
-
-	readList, readListDiags := flattenStringSlice(ctx, readNames)
-	diags.Append(readListDiags...)
-	writeList, writeListDiags := flattenStringSlice(ctx, writeNames)
-	diags.Append(writeListDiags...)
-
 	state := modelResourceModel{
 		ID:                   types.StringValue(resp.ID),
 		ModelID:              types.StringValue(requestedID),
@@ -588,8 +648,6 @@ func modelResponseToModel(ctx context.Context, apiClient *client.Client, resp *c
 		Params:               paramsModel,
 		ParamsAdditionalJSON: paramsAdditional,
 		MetaAdditionalJSON:   metaAdditional,
-		ReadGroups:           readList,
-		WriteGroups:          writeList,
 		ProfileImageURL:      metaState.ProfileImageURL,
 		Description:          metaState.Description,
 		SuggestionPrompts:    metaState.SuggestionPrompts,
@@ -597,6 +655,7 @@ func modelResponseToModel(ctx context.Context, apiClient *client.Client, resp *c
 		ToolIDs:              metaState.ToolIDs,
 		DefaultFeatureIDs:    metaState.DefaultFeatureIDs,
 		Capabilities:         metaState.Capabilities,
+		accessControlModel:   flattenAccessControl(ctx, apiClient, resp.AccessControl, prior, &diags),
 	}
 
 	if resp.BaseModelID != nil && *resp.BaseModelID != "" {
@@ -765,7 +824,7 @@ func expandModelMeta(ctx context.Context, plan *modelResourceModel, diags *diag.
 		}
 	}
 	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
-		tags := expandStringList(ctx, plan.Tags, path.Root("tags"), diags)
+		tags := expandStringSet(ctx, plan.Tags, path.Root("tags"), diags)
 		ensureMap()
 		if len(tags) > 0 {
 			items := make([]map[string]any, 0, len(tags))
@@ -1040,7 +1099,7 @@ func flattenModelMeta(ctx context.Context, data map[string]any) (modelMetaState,
 		ProfileImageURL:   types.StringNull(),
 		Description:       types.StringNull(),
 		SuggestionPrompts: types.ListNull(types.StringType),
-		Tags:              types.ListNull(types.StringType),
+		Tags:              types.SetNull(types.StringType),
 		ToolIDs:           types.ListNull(types.StringType),
 		DefaultFeatureIDs: types.ListNull(types.StringType),
 		Capabilities:      nil,
@@ -1087,10 +1146,10 @@ func flattenModelMeta(ctx context.Context, data map[string]any) (modelMetaState,
 	if raw, ok := data["tags"]; ok && raw != nil {
 		tags, convOK := toKeyedStringSlice(raw, "name")
 		if convOK {
-			list, listDiags := types.ListValueFrom(ctx, types.StringType, tags)
-			diags.Append(listDiags...)
-			if !listDiags.HasError() {
-				state.Tags = list
+			set, setDiags := types.SetValueFrom(ctx, types.StringType, uniqueStrings(tags))
+			diags.Append(setDiags...)
+			if !setDiags.HasError() {
+				state.Tags = set
 			}
 			delete(additional, "tags")
 		} else {
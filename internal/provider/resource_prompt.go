@@ -4,13 +4,16 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
@@ -19,10 +22,12 @@ import (
 var _ resource.Resource = &promptResource{}
 var _ resource.ResourceWithConfigure = &promptResource{}
 var _ resource.ResourceWithImportState = &promptResource{}
+var _ resource.ResourceWithUpgradeState = &promptResource{}
 
 // promptResource implements Terraform management for prompts.
 type promptResource struct {
-	client *client.Client
+	client    *client.Client
+	userMatch string
 }
 
 // normalizePromptCommand ensures commands sent to the API always include a single
@@ -38,14 +43,13 @@ func normalizePromptCommand(command string) string {
 
 // promptResourceModel describes Terraform state.
 type promptResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Command     types.String `tfsdk:"command"`
-	Title       types.String `tfsdk:"title"`
-	Content     types.String `tfsdk:"content"`
-	ReadGroups  types.List   `tfsdk:"read_groups"`
-	WriteGroups types.List   `tfsdk:"write_groups"`
-	Timestamp   types.String `tfsdk:"timestamp"`
-	UserID      types.String `tfsdk:"user_id"`
+	ID        types.String `tfsdk:"id"`
+	Command   types.String `tfsdk:"command"`
+	Title     types.String `tfsdk:"title"`
+	Content   types.String `tfsdk:"content"`
+	Timestamp types.String `tfsdk:"timestamp"`
+	UserID    types.String `tfsdk:"user_id"`
+	accessControlModel
 }
 
 // NewPromptResource returns a configured resource instance.
@@ -61,6 +65,7 @@ func (r *promptResource) Metadata(_ context.Context, req resource.MetadataReques
 // Schema defines the prompt resource schema.
 func (r *promptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:      true,
@@ -83,19 +88,59 @@ func (r *promptResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 				Description: "Prompt content text.",
 			},
-			"read_groups": schema.ListAttribute{
+			"read_groups": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Group names or IDs granted read access to the prompt.",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenConfigured(path.Root("read_group_ids"), path.Root("write_group_ids")), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
 			},
-			"write_groups": schema.ListAttribute{
+			"write_groups": schema.SetAttribute{
 				ElementType:   types.StringType,
 				Optional:      true,
 				Computed:      true,
 				Description:   "Group names or IDs granted write access to the prompt (also receive read access).",
-				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenConfigured(path.Root("read_group_ids"), path.Root("write_group_ids")), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"read_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group IDs granted read access to the prompt, used verbatim. Conflicts with `read_groups` and `write_groups`.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("read_groups"), path.MatchRoot("write_groups")),
+				},
+			},
+			"write_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Group IDs granted write access to the prompt (also receive read access), used verbatim. Conflicts with `read_groups` and `write_groups`.",
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("read_groups"), path.MatchRoot("write_groups")),
+				},
+			},
+			"read_users": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email addresses, usernames or IDs of users granted read access to the prompt.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"write_users": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email addresses, usernames or IDs of users granted write access to the prompt (also receive read access).",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), nullWhenStringIn(path.Root("visibility"), visibilityPublic, visibilityPrivate)},
+			},
+			"visibility": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Who can access the prompt: `public` (everyone), `private` (only the owner) or `restricted` (the owner plus the granted groups and users). Defaults to `restricted` when grants are configured; otherwise the current visibility is kept, and new records without grants are public.",
+				PlanModifiers: []planmodifier.String{visibilityPlanModifier{}},
+				Validators: []validator.String{
+					stringvalidator.OneOf(visibilityPublic, visibilityPrivate, visibilityRestricted),
+					visibilityValidator{},
+				},
 			},
 			"timestamp": schema.StringAttribute{
 				Computed:      true,
@@ -116,8 +161,9 @@ func (r *promptResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	if client, ok := req.ProviderData.(*client.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.userMatch = data.userMatch
 	}
 }
 
@@ -141,12 +187,7 @@ func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest,
 		Content: plan.Content.ValueString(),
 	}
 
-	readNames := expandStringList(ctx, plan.ReadGroups, path.Root("read_groups"), &resp.Diagnostics)
-	writeNames := expandStringList(ctx, plan.WriteGroups, path.Root("write_groups"), &resp.Diagnostics)
-	readIDs := resolveGroupNamesToIDs(ctx, r.client, readNames, path.Root("read_groups"), &resp.Diagnostics)
-	writeIDs := resolveGroupNamesToIDs(ctx, r.client, writeNames, path.Root("write_groups"), &resp.Diagnostics)
-
-	form.AccessControl = buildAccessControl(readIDs, writeIDs)
+	form.AccessControl = expandAccessControl(ctx, r.client, plan.accessControlModel, r.userMatch, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -157,7 +198,7 @@ func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	state, diags := promptResponseToModel(ctx, r.client, created)
+	state, diags := promptResponseToModel(ctx, r.client, created, plan.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -193,7 +234,7 @@ func (r *promptResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	updated, diags := promptResponseToModel(ctx, r.client, current)
+	updated, diags := promptResponseToModel(ctx, r.client, current, state.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -231,12 +272,7 @@ func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Content: plan.Content.ValueString(),
 	}
 
-	readNames := expandStringList(ctx, plan.ReadGroups, path.Root("read_groups"), &resp.Diagnostics)
-	writeNames := expandStringList(ctx, plan.WriteGroups, path.Root("write_groups"), &resp.Diagnostics)
-	readIDs := resolveGroupNamesToIDs(ctx, r.client, readNames, path.Root("read_groups"), &resp.Diagnostics)
-	writeIDs := resolveGroupNamesToIDs(ctx, r.client, writeNames, path.Root("write_groups"), &resp.Diagnostics)
-
-	form.AccessControl = buildAccessControl(readIDs, writeIDs)
+	form.AccessControl = expandAccessControl(ctx, r.client, plan.accessControlModel, r.userMatch, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -247,7 +283,7 @@ func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	state, diags := promptResponseToModel(ctx, r.client, updatedPrompt)
+	state, diags := promptResponseToModel(ctx, r.client, updatedPrompt, plan.accessControlModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -282,6 +318,13 @@ func (r *promptResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// UpgradeState converts state written while the string collections were lists.
+func (r *promptResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeListsToSets("read_groups", "write_groups", "read_group_ids", "write_group_ids", "read_users", "write_users"),
+	}
+}
+
 // ImportState allows importing by command identifier.
 func (r *promptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("command"), req, resp)
@@ -289,44 +332,17 @@ func (r *promptResource) ImportState(ctx context.Context, req resource.ImportSta
 }
 
 // promptResponseToModel maps API objects into Terraform state structures.
-func promptResponseToModel(ctx context.Context, apiClient *client.Client, resp *client.PromptModel) (promptResourceModel, diag.Diagnostics) {
+func promptResponseToModel(ctx context.Context, apiClient *client.Client, resp *client.PromptModel, prior accessControlModel) (promptResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	readIDs := extractGroupIDsFromAccessControl(resp.AccessControl, "read")
-	writeIDs := extractGroupIDsFromAccessControl(resp.AccessControl, "write")
-
-	readNames, readDiags := fetchGroupNamesForIDs(ctx, apiClient, readIDs)
-	diags.Append(readDiags...)
-	writeNames, writeDiags := fetchGroupNamesForIDs(ctx, apiClient, writeIDs)
-	diags.Append(writeDiags...)
-
-	readList := types.ListNull(types.StringType)
-	if len(readNames) > 0 {
-		l, listDiags := types.ListValueFrom(ctx, types.StringType, readNames)
-		diags.Append(listDiags...)
-		if !listDiags.HasError() {
-			readList = l
-		}
-	}
-
-	writeList := types.ListNull(types.StringType)
-	if len(writeNames) > 0 {
-		l, listDiags := types.ListValueFrom(ctx, types.StringType, writeNames)
-		diags.Append(listDiags...)
-		if !listDiags.HasError() {
-			writeList = l
-		}
-	}
-
 	state := promptResourceModel{
-		ID:          types.StringValue(resp.Command),
-		Command:     types.StringValue(resp.Command),
-		Title:       types.StringValue(resp.Title),
-		Content:     types.StringValue(resp.Content),
-		ReadGroups:  readList,
-		WriteGroups: writeList,
-		Timestamp:   formatDateValue(resp.Timestamp),
-		UserID:      types.StringValue(resp.UserID),
+		ID:                 types.StringValue(resp.Command),
+		Command:            types.StringValue(resp.Command),
+		Title:              types.StringValue(resp.Title),
+		Content:            types.StringValue(resp.Content),
+		Timestamp:          formatDateValue(resp.Timestamp),
+		UserID:             types.StringValue(resp.UserID),
+		accessControlModel: flattenAccessControl(ctx, apiClient, resp.AccessControl, prior, &diags),
 	}
 
 	return state, diags
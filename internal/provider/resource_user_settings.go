@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nickcecere/terraform-provider-openwebui/internal/client"
+)
+
+var _ resource.Resource = &userSettingsResource{}
+var _ resource.ResourceWithConfigure = &userSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &userSettingsResource{}
+
+// Keys of the ui settings object that are exposed as typed attributes.
+const (
+	uiSettingModels              = "models"
+	uiSettingSystem              = "system"
+	uiSettingNotificationEnabled = "notificationEnabled"
+	uiSettingNotificationSound   = "notificationSound"
+	uiSettingWidescreenMode      = "widescreenMode"
+	uiSettingChatBubble          = "chatBubble"
+)
+
+// userSettingsTypedKeys maps typed attributes to their ui settings keys.
+var userSettingsTypedKeys = map[string]string{
+	"default_models":       uiSettingModels,
+	"system_prompt":        uiSettingSystem,
+	"notification_enabled": uiSettingNotificationEnabled,
+	"notification_sound":   uiSettingNotificationSound,
+	"widescreen_mode":      uiSettingWidescreenMode,
+	"chat_bubble":          uiSettingChatBubble,
+}
+
+// userSettingsResource manages the settings of the identity the provider authenticates as.
+type userSettingsResource struct {
+	client *client.Client
+}
+
+// userSettingsResourceModel maps Terraform state.
+type userSettingsResourceModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	DefaultModels       types.List           `tfsdk:"default_models"`
+	SystemPrompt        types.String         `tfsdk:"system_prompt"`
+	NotificationEnabled types.Bool           `tfsdk:"notification_enabled"`
+	NotificationSound   types.Bool           `tfsdk:"notification_sound"`
+	WidescreenMode      types.Bool           `tfsdk:"widescreen_mode"`
+	ChatBubble          types.Bool           `tfsdk:"chat_bubble"`
+	UIJSON              jsontypes.Normalized `tfsdk:"ui_json"`
+	InfoJSON            jsontypes.Normalized `tfsdk:"info_json"`
+}
+
+// NewUserSettingsResource constructs a new resource instance.
+func NewUserSettingsResource() resource.Resource {
+	return &userSettingsResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *userSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_settings"
+}
+
+// Schema defines the resource schema for user settings.
+func (r *userSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Identifier of the user whose settings are managed.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"default_models": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Model IDs selected by default for new chats (`ui.models`).",
+			},
+			"system_prompt": schema.StringAttribute{
+				Optional:    true,
+				Description: "System prompt applied to new chats (`ui.system`).",
+			},
+			"notification_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether desktop notifications are shown for responses (`ui.notificationEnabled`).",
+			},
+			"notification_sound": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether a sound is played for notifications (`ui.notificationSound`).",
+			},
+			"widescreen_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether chats use the full window width (`ui.widescreenMode`).",
+			},
+			"chat_bubble": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether messages are shown as chat bubbles (`ui.chatBubble`).",
+			},
+			"ui_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+				Description: "JSON object deep-merged into the `ui` settings for keys without a typed attribute.",
+			},
+			"info_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+				Description: "JSON object merged into the user's free-form info document.",
+			},
+		},
+	}
+}
+
+// Configure connects the API client to the resource.
+func (r *userSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+// ValidateConfig rejects ui_json keys that are covered by typed attributes.
+func (r *userSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config userSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.UIJSON.IsNull() || config.UIJSON.IsUnknown() {
+		return
+	}
+
+	ui := decodeOptionalJSON(config.UIJSON.StringValue, path.Root("ui_json"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var conflicts []string
+	for attribute, key := range userSettingsTypedKeys {
+		if _, ok := ui[key]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s (use %s)", key, attribute))
+		}
+	}
+	if len(conflicts) == 0 {
+		return
+	}
+
+	sort.Strings(conflicts)
+	resp.Diagnostics.AddAttributeError(
+		path.Root("ui_json"),
+		"Conflicting ui settings",
+		fmt.Sprintf("The following keys are managed by typed attributes and must not be set in ui_json: %s.", strings.Join(conflicts, ", ")),
+	)
+}
+
+// Create applies the settings.
+func (r *userSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing user settings.")
+		return
+	}
+
+	var plan userSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, ok := applyUserSettings(ctx, r.client, plan, nil, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the managed settings from the API.
+func (r *userSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing user settings.")
+		return
+	}
+
+	var state userSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, ok := readUserSettingsState(ctx, r.client, state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Update applies the settings and removes keys that are no longer managed.
+func (r *userSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing user settings.")
+		return
+	}
+
+	var plan, state userSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, ok := applyUserSettings(ctx, r.client, plan, &state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
+}
+
+// Delete removes the managed keys from the settings.
+func (r *userSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Unconfigured API client", "Expected provider to configure the Open WebUI client before managing user settings.")
+		return
+	}
+
+	var state userSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clearUserSettings(ctx, r.client, state, &resp.Diagnostics)
+}
+
+// applyUserSettings writes the planned settings, removing keys managed by prior that
+// the plan no longer sets, and returns the refreshed state.
+func applyUserSettings(ctx context.Context, apiClient *client.Client, plan userSettingsResourceModel, prior *userSettingsResourceModel, diags *diag.Diagnostics) (userSettingsResourceModel, bool) {
+	settings, err := apiClient.GetUserSettings(ctx)
+	if err != nil {
+		diags.AddError("Read user settings failed", err.Error())
+		return plan, false
+	}
+
+	ui := make(map[string]any, len(settings.UI))
+	for k, v := range settings.UI {
+		ui[k] = v
+	}
+
+	if prior != nil {
+		_, removed := diffStringSets(managedUIKeys(*prior, diags), managedUIKeys(plan, diags))
+		for _, key := range removed {
+			delete(ui, key)
+		}
+	}
+
+	uiOverlay := decodeOptionalJSON(plan.UIJSON.StringValue, path.Root("ui_json"), diags)
+	if diags.HasError() {
+		return plan, false
+	}
+	ui = mergeJSONObjects(ui, uiOverlay)
+	setTypedUISettings(ctx, plan, ui, diags)
+	if diags.HasError() {
+		return plan, false
+	}
+
+	if _, err := apiClient.UpdateUserSettings(ctx, client.UserSettings{UI: ui}); err != nil {
+		diags.AddError("Update user settings failed", err.Error())
+		return plan, false
+	}
+
+	info := decodeOptionalJSON(plan.InfoJSON.StringValue, path.Root("info_json"), diags)
+	if diags.HasError() {
+		return plan, false
+	}
+	if prior != nil {
+		priorInfo := decodeOptionalJSON(prior.InfoJSON.StringValue, path.Root("info_json"), diags)
+		_, removed := diffStringSets(jsonObjectKeys(priorInfo), jsonObjectKeys(info))
+		for _, key := range removed {
+			if info == nil {
+				info = make(map[string]any)
+			}
+			info[key] = nil
+		}
+	}
+	if len(info) > 0 {
+		if _, err := apiClient.UpdateUserInfo(ctx, info); err != nil {
+			diags.AddError("Update user info failed", err.Error())
+			return plan, false
+		}
+	}
+
+	return readUserSettingsState(ctx, apiClient, plan, diags)
+}
+
+// clearUserSettings removes the ui keys managed by prior and resets its info keys.
+func clearUserSettings(ctx context.Context, apiClient *client.Client, prior userSettingsResourceModel, diags *diag.Diagnostics) {
+	uiKeys := managedUIKeys(prior, diags)
+	if len(uiKeys) > 0 {
+		settings, err := apiClient.GetUserSettings(ctx)
+		if err != nil {
+			diags.AddError("Read user settings failed", err.Error())
+			return
+		}
+
+		ui := make(map[string]any, len(settings.UI))
+		for k, v := range settings.UI {
+			ui[k] = v
+		}
+		for _, key := range uiKeys {
+			delete(ui, key)
+		}
+
+		if _, err := apiClient.UpdateUserSettings(ctx, client.UserSettings{UI: ui}); err != nil {
+			diags.AddError("Update user settings failed", err.Error())
+			return
+		}
+	}
+
+	priorInfo := decodeOptionalJSON(prior.InfoJSON.StringValue, path.Root("info_json"), diags)
+	if len(priorInfo) == 0 {
+		return
+	}
+
+	info := make(map[string]any, len(priorInfo))
+	for key := range priorInfo {
+		info[key] = nil
+	}
+	if _, err := apiClient.UpdateUserInfo(ctx, info); err != nil {
+		diags.AddError("Update user info failed", err.Error())
+	}
+}
+
+// readUserSettingsState refreshes the attributes that prior manages.
+func readUserSettingsState(ctx context.Context, apiClient *client.Client, prior userSettingsResourceModel, diags *diag.Diagnostics) (userSettingsResourceModel, bool) {
+	user, err := apiClient.GetSessionUser(ctx)
+	if err != nil {
+		diags.AddError("Read session user failed", err.Error())
+		return prior, false
+	}
+
+	settings, err := apiClient.GetUserSettings(ctx)
+	if err != nil {
+		diags.AddError("Read user settings failed", err.Error())
+		return prior, false
+	}
+
+	state := prior
+	state.ID = types.StringValue(user.ID)
+	readTypedUISettings(ctx, settings.UI, &state, diags)
+
+	if !prior.UIJSON.IsNull() {
+		state.UIJSON = projectNormalizedJSON(settings.UI, prior.UIJSON, path.Root("ui_json"), diags)
+	}
+
+	if !prior.InfoJSON.IsNull() {
+		info, err := apiClient.GetUserInfo(ctx)
+		if err != nil {
+			diags.AddError("Read user info failed", err.Error())
+			return prior, false
+		}
+		state.InfoJSON = projectNormalizedJSON(info, prior.InfoJSON, path.Root("info_json"), diags)
+	}
+
+	return state, !diags.HasError()
+}
+
+// setTypedUISettings writes the configured typed attributes into ui.
+func setTypedUISettings(ctx context.Context, model userSettingsResourceModel, ui map[string]any, diags *diag.Diagnostics) {
+	if !model.DefaultModels.IsNull() {
+		models := expandStringList(ctx, model.DefaultModels, path.Root("default_models"), diags)
+		if models == nil {
+			models = []string{}
+		}
+		ui[uiSettingModels] = models
+	}
+	if !model.SystemPrompt.IsNull() {
+		ui[uiSettingSystem] = model.SystemPrompt.ValueString()
+	}
+
+	for key, value := range typedUIBools(model) {
+		if !value.IsNull() {
+			ui[key] = value.ValueBool()
+		}
+	}
+}
+
+// readTypedUISettings refreshes the typed attributes that model sets from ui.
+func readTypedUISettings(ctx context.Context, ui map[string]any, model *userSettingsResourceModel, diags *diag.Diagnostics) {
+	if !model.DefaultModels.IsNull() {
+		model.DefaultModels = types.ListNull(types.StringType)
+		if raw, ok := ui[uiSettingModels].([]any); ok {
+			models := make([]string, 0, len(raw))
+			for _, item := range raw {
+				if s, ok := item.(string); ok {
+					models = append(models, s)
+				}
+			}
+			list, listDiags := types.ListValueFrom(ctx, types.StringType, models)
+			diags.Append(listDiags...)
+			model.DefaultModels = list
+		}
+	}
+
+	if !model.SystemPrompt.IsNull() {
+		model.SystemPrompt = types.StringNull()
+		if s, ok := ui[uiSettingSystem].(string); ok {
+			model.SystemPrompt = types.StringValue(s)
+		}
+	}
+
+	readBool := func(value *types.Bool, key string) {
+		if value.IsNull() {
+			return
+		}
+		*value = types.BoolNull()
+		if b, ok := ui[key].(bool); ok {
+			*value = types.BoolValue(b)
+		}
+	}
+	readBool(&model.NotificationEnabled, uiSettingNotificationEnabled)
+	readBool(&model.NotificationSound, uiSettingNotificationSound)
+	readBool(&model.WidescreenMode, uiSettingWidescreenMode)
+	readBool(&model.ChatBubble, uiSettingChatBubble)
+}
+
+// typedUIBools returns the boolean typed attributes keyed by their ui settings key.
+func typedUIBools(model userSettingsResourceModel) map[string]types.Bool {
+	return map[string]types.Bool{
+		uiSettingNotificationEnabled: model.NotificationEnabled,
+		uiSettingNotificationSound:   model.NotificationSound,
+		uiSettingWidescreenMode:      model.WidescreenMode,
+		uiSettingChatBubble:          model.ChatBubble,
+	}
+}
+
+// managedUIKeys lists the top-level ui keys that model manages.
+func managedUIKeys(model userSettingsResourceModel, diags *diag.Diagnostics) []string {
+	var keys []string
+	if !model.DefaultModels.IsNull() {
+		keys = append(keys, uiSettingModels)
+	}
+	if !model.SystemPrompt.IsNull() {
+		keys = append(keys, uiSettingSystem)
+	}
+	for key, value := range typedUIBools(model) {
+		if !value.IsNull() {
+			keys = append(keys, key)
+		}
+	}
+
+	ui := decodeOptionalJSON(model.UIJSON.StringValue, path.Root("ui_json"), diags)
+	return append(keys, jsonObjectKeys(ui)...)
+}
+
+// jsonObjectKeys returns the top-level keys of a decoded JSON object.
+func jsonObjectKeys(data map[string]any) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeListsToSets returns a state upgrader for schema version 0, in which the
+// given top-level attributes were lists. The raw state is passed through with
+// duplicate elements removed so it decodes as sets.
+func upgradeListsToSets(attributes ...string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || len(req.RawState.JSON) == 0 {
+				resp.Diagnostics.AddError("Unable to upgrade state", "The prior state is missing its JSON representation.")
+				return
+			}
+
+			var state map[string]any
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Decoding prior state failed: %v", err))
+				return
+			}
+
+			for _, attribute := range attributes {
+				values, ok := state[attribute].([]any)
+				if !ok {
+					continue
+				}
+				state[attribute] = uniqueJSONValues(values)
+			}
+
+			upgraded, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Encoding upgraded state failed: %v", err))
+				return
+			}
+
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
+
+// uniqueJSONValues removes repeated string elements while keeping the first occurrence.
+func uniqueJSONValues(values []any) []any {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			if _, dup := seen[s]; dup {
+				continue
+			}
+			seen[s] = struct{}{}
+		}
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestUniqueJSONValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []any
+		want   []any
+	}{
+		{name: "empty", values: []any{}, want: []any{}},
+		{name: "no duplicates", values: []any{"a", "b"}, want: []any{"a", "b"}},
+		{name: "keeps first occurrence", values: []any{"b", "a", "b", "a"}, want: []any{"b", "a"}},
+		{name: "case sensitive", values: []any{"A", "a"}, want: []any{"A", "a"}},
+		{name: "non-string values pass through", values: []any{1.0, 1.0, nil, "a", "a"}, want: []any{1.0, 1.0, nil, "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := uniqueJSONValues(tt.values)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("uniqueJSONValues(%#v) = %#v, want %#v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradeListsToSets(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes []string
+		raw        string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "removes duplicates from listed attributes",
+			attributes: []string{"users"},
+			raw:        `{"id":"g1","users":["a","b","a"]}`,
+			want:       `{"id":"g1","users":["a","b"]}`,
+		},
+		{
+			name:       "leaves other attributes alone",
+			attributes: []string{"users"},
+			raw:        `{"users":["a"],"other":["x","x"]}`,
+			want:       `{"users":["a"],"other":["x","x"]}`,
+		},
+		{
+			name:       "null and missing attributes",
+			attributes: []string{"users", "admins"},
+			raw:        `{"users":null}`,
+			want:       `{"users":null}`,
+		},
+		{
+			name:       "invalid JSON",
+			attributes: []string{"users"},
+			raw:        `{`,
+			wantErr:    true,
+		},
+		{
+			name:       "empty state",
+			attributes: []string{"users"},
+			raw:        ``,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.raw)}}
+			resp := &resource.UpgradeStateResponse{}
+			upgradeListsToSets(tt.attributes...).StateUpgrader(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var got, want map[string]any
+			if err := json.Unmarshal(resp.DynamicValue.JSON, &got); err != nil {
+				t.Fatalf("decoding upgraded state: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatalf("decoding expected state: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("upgraded state = %s, want %s", resp.DynamicValue.JSON, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupStateUpgradeFromV0(t *testing.T) {
+	ctx := context.Background()
+	r := &groupResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", schemaResp.Diagnostics)
+	}
+
+	// Version 0 stored users and admins as lists, which could hold duplicates.
+	raw := `{
+		"id": "group-1",
+		"name": "Support",
+		"description": "Support team",
+		"users": ["alice@example.com", "bob", "alice@example.com"],
+		"admins": ["alice@example.com", "alice@example.com"],
+		"permissions": {"workspace": {"models": true}},
+		"user_id": "owner",
+		"created_at": "2024-01-01",
+		"updated_at": "2024-01-02"
+	}`
+
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(raw)}}
+	resp := &resource.UpgradeStateResponse{}
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrade: %v", resp.Diagnostics)
+	}
+
+	value, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("decoding upgraded state against the version 1 schema: %v", err)
+	}
+
+	var model groupResourceModel
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: value}
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("reading upgraded state: %v", diags)
+	}
+
+	if got := model.ID.ValueString(); got != "group-1" {
+		t.Errorf("id = %q, want %q", got, "group-1")
+	}
+
+	var users, admins []string
+	model.Users.ElementsAs(ctx, &users, false)
+	model.Admins.ElementsAs(ctx, &admins, false)
+	if len(users) != 2 {
+		t.Errorf("users = %v, want two unique entries", users)
+	}
+	if !reflect.DeepEqual(admins, []string{"alice@example.com"}) {
+		t.Errorf("admins = %v, want [alice@example.com]", admins)
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator ensures a string attribute parses as a positive Go duration.
+type durationValidator struct{}
+
+// Description implements validator.String.
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30s, 5m or 1h"
+}
+
+// MarkdownDescription implements validator.String.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements validator.String.
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	parsed, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || parsed <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Expected a positive duration such as 30s, 5m or 1h, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = regexValidator{}
+
+// regexValidator ensures a string attribute compiles as a Go regular expression.
+type regexValidator struct{}
+
+// Description implements validator.String.
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+// MarkdownDescription implements validator.String.
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements validator.String.
+func (v regexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			fmt.Sprintf("Unable to compile %q: %v", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+var _ validator.String = visibilityValidator{}
+
+// visibilityValidator rejects access grants on public and private records.
+type visibilityValidator struct{}
+
+// Description implements validator.String.
+func (v visibilityValidator) Description(_ context.Context) string {
+	return "public and private records must not grant access to groups or users"
+}
+
+// MarkdownDescription implements validator.String.
+func (v visibilityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements validator.String.
+func (v visibilityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	visibility := req.ConfigValue.ValueString()
+	if visibility != visibilityPublic && visibility != visibilityPrivate {
+		return
+	}
+
+	for _, grantPath := range accessGrantPaths {
+		var grants types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, grantPath, &grants)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !grants.IsNull() && (grants.IsUnknown() || len(grants.Elements()) > 0) {
+			resp.Diagnostics.AddAttributeError(
+				grantPath,
+				"Conflicting access grants",
+				fmt.Sprintf("visibility = %q does not allow %s. Set visibility to %q to grant access to groups or users.", visibility, grantPath, visibilityRestricted),
+			)
+		}
+	}
+}